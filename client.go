@@ -9,17 +9,21 @@ import (
 
 // ClientNode manipulating Client resource.
 type ClientNode struct {
-	endpoint string
-	apiKey   string
+	endpoint  string
+	requester *requester
 }
 
 // Client represent Clockify's client resource.
 // See: https://clockify.me/developers-api#tag-Client
 type Client struct {
-	ID          string `json:"id,omitempty"`
-	Name        string `json:"name,omitempty"`
-	WorkspaceID string `json:"workspaceId,omitempty"`
-	Archived    bool   `json:"archived,omitempty"`
+	ID          ClientID    `json:"id,omitempty"`
+	Name        string      `json:"name,omitempty"`
+	WorkspaceID WorkspaceID `json:"workspaceId,omitempty"`
+	Archived    bool        `json:"archived,omitempty"`
+
+	// Extra holds JSON properties unknown to this package.
+	// See: WithPreserveUnknownFields.
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 const (
@@ -58,37 +62,35 @@ type clientAddFields struct {
 }
 
 type clientUpdateFields struct {
-	Archived *bool  `json:"archived,omitempty"`
-	Name     string `json:"name,omitempty"`
+	Archived *bool   `json:"archived,omitempty"`
+	Name     *string `json:"name,omitempty"`
 }
 
 // All get all Client resource based on filter given.
-func (c *ClientNode) All(workspaceID string, opts ...RequestOption) ([]Client, error) {
+func (c *ClientNode) All(workspaceID WorkspaceID, opts ...RequestOption) ([]Client, error) {
 	endpoint := fmt.Sprintf("%s/workspaces/%s/clients", c.endpoint, workspaceID)
-	res, err := get(clientAllRequest(c.apiKey, endpoint, opts))
+	res, err := c.requester.get(clientAllRequest(endpoint, opts))
 	if err != nil {
 		return nil, fmt.Errorf("get: %w", err)
 	}
 	result := make([]Client, 0)
-	if err := json.Unmarshal(res, &result); err != nil {
-		if jErr, ok := err.(*json.UnmarshalTypeError); ok {
-			return nil, fmt.Errorf("unmarshal field %v of type %v", jErr.Field, jErr.Type)
-		}
-		return nil, fmt.Errorf("json unmarshal: %w", err)
+	if err := c.requester.unmarshal(res, &result); err != nil {
+		return nil, err
 	}
 	return result, nil
 }
 
-func clientAllRequest(apiKey string, endpoint string, options []RequestOption) requestOptions {
+func clientAllRequest(endpoint string, options []RequestOption) requestOptions {
 	res := requestOptions{
-		apiKey:   apiKey,
-		endpoint: endpoint,
+		resource:  ResourceClient,
+		operation: OperationList,
+		endpoint:  endpoint,
 	}
 	res.params = url.Values{}
 	res.params.Add(archivedKey, strconv.FormatBool(false))
 	res.params.Add(pageKey, strconv.Itoa(defaultPage))
 	res.params.Add(pageSizeKey, strconv.Itoa(defaultPageSize))
-	res.params.Add(sortOrderKey, defaultSortOrder)
+	res.params.Add(sortOrderKey, string(defaultSortOrder))
 	for _, opt := range options {
 		if opt.paramsProvider != nil {
 			opt.paramsProvider(res.params)
@@ -100,25 +102,23 @@ func clientAllRequest(apiKey string, endpoint string, options []RequestOption) r
 }
 
 // Get one Client by its id.
-func (c *ClientNode) Get(workspaceID string, id string, opts ...RequestOption) (*Client, error) {
+func (c *ClientNode) Get(workspaceID WorkspaceID, id ClientID, opts ...RequestOption) (*Client,
+	error) {
 	endpoint := fmt.Sprintf("%s/workspaces/%s/clients/%s", c.endpoint, workspaceID, id)
-	res, err := get(clientGetRequest(c.apiKey, endpoint, opts))
+	res, err := c.requester.get(clientGetRequest(endpoint, opts))
 	if err != nil {
 		return nil, fmt.Errorf("get: %w", err)
 	}
 	result := new(Client)
-	if err := json.Unmarshal(res, &result); err != nil {
-		if jErr, ok := err.(*json.UnmarshalTypeError); ok {
-			return nil, fmt.Errorf("unmarshal field %v of type %v", jErr.Field, jErr.Type)
-		}
-		return nil, fmt.Errorf("json unmarshal: %w", err)
+	if err := c.requester.unmarshal(res, &result); err != nil {
+		return nil, err
 	}
 	return result, nil
 }
 
-func clientGetRequest(apiKey string, endpoint string, options []RequestOption) requestOptions {
+func clientGetRequest(endpoint string, options []RequestOption) requestOptions {
 	res := requestOptions{
-		apiKey:   apiKey,
+		resource: ResourceClient,
 		endpoint: endpoint,
 	}
 	res.params = url.Values{}
@@ -128,26 +128,24 @@ func clientGetRequest(apiKey string, endpoint string, options []RequestOption) r
 }
 
 // Add create new Client based on fields given.
-func (c *ClientNode) Add(workspaceID string, name string, opts ...RequestOption) (*Client, error) {
+func (c *ClientNode) Add(workspaceID WorkspaceID, name string, opts ...RequestOption) (*Client,
+	error) {
 	endpoint := fmt.Sprintf("%s/workspaces/%s/clients", c.endpoint, workspaceID)
-	res, err := post(clientAddRequest(c.apiKey, endpoint, name, opts))
+	res, err := c.requester.post(clientAddRequest(endpoint, name, opts))
 	if err != nil {
 		return nil, fmt.Errorf("post: %w", err)
 	}
 	result := new(Client)
-	if err := json.Unmarshal(res, &result); err != nil {
-		if jErr, ok := err.(*json.UnmarshalTypeError); ok {
-			return nil, fmt.Errorf("unmarshal field %v of type %v", jErr.Field, jErr.Type)
-		}
-		return nil, fmt.Errorf("json unmarshal: %w", err)
+	if err := c.requester.unmarshal(res, &result); err != nil {
+		return nil, err
 	}
 	return result, nil
 }
 
-func clientAddRequest(apiKey string, endpoint string, name string,
+func clientAddRequest(endpoint string, name string,
 	options []RequestOption) requestOptions {
 	res := requestOptions{
-		apiKey:   apiKey,
+		resource: ResourceClient,
 		endpoint: endpoint,
 	}
 	res.fields = clientAddFields{Name: name}
@@ -156,27 +154,25 @@ func clientAddRequest(apiKey string, endpoint string, name string,
 	return res
 }
 
-// Update existing Client based on fields and options given.
-func (c *ClientNode) Update(workspaceID string, id string, opts ...RequestOption) (*Client,
+// Update existing Client based on options given. Only fields set by options
+// are sent, so fields not given are left unchanged.
+func (c *ClientNode) Update(workspaceID WorkspaceID, id ClientID, opts ...RequestOption) (*Client,
 	error) {
 	endpoint := fmt.Sprintf("%s/workspaces/%s/clients/%s", c.endpoint, workspaceID, id)
-	res, err := put(clientUpdateRequest(c.apiKey, endpoint, opts))
+	res, err := c.requester.put(clientUpdateRequest(endpoint, opts))
 	if err != nil {
 		return nil, fmt.Errorf("put: %w", err)
 	}
 	result := new(Client)
-	if err := json.Unmarshal(res, &result); err != nil {
-		if jErr, ok := err.(*json.UnmarshalTypeError); ok {
-			return nil, fmt.Errorf("unmarshal field %v of type %v", jErr.Field, jErr.Type)
-		}
-		return nil, fmt.Errorf("json unmarshal: %w", err)
+	if err := c.requester.unmarshal(res, &result); err != nil {
+		return nil, err
 	}
 	return result, nil
 }
 
-func clientUpdateRequest(apiKey string, endpoint string, options []RequestOption) requestOptions {
+func clientUpdateRequest(endpoint string, options []RequestOption) requestOptions {
 	res := requestOptions{
-		apiKey:   apiKey,
+		resource: ResourceClient,
 		endpoint: endpoint,
 	}
 	res.params = url.Values{}
@@ -197,41 +193,44 @@ func clientUpdateRequest(apiKey string, endpoint string, options []RequestOption
 				val, _ := strconv.ParseBool(params.Get(key))
 				fields.Archived = &val
 			case nameKey:
-				fields.Name = params.Get(key)
+				val := params.Get(key)
+				fields.Name = &val
 			}
 
 		}
 	}
+	res.fields = withExtraFields(fields, res.params)
 	res.params.Del(archivedKey)
 	res.params.Del(nameKey)
-	res.fields = fields
+	res.params.Del(extraFieldsKey)
 
 	injectContext(&res, options)
 
 	return res
 }
 
-// Delete existing Client.
-func (c *ClientNode) Delete(workspaceID string, id string, opts ...RequestOption) (*Client,
+// Delete existing Client, and return the deleted Client. Nil Client is returned when
+// Clockify respond without body.
+func (c *ClientNode) Delete(workspaceID WorkspaceID, id ClientID, opts ...RequestOption) (*Client,
 	error) {
 	endpoint := fmt.Sprintf("%s/workspaces/%s/clients/%s", c.endpoint, workspaceID, id)
-	res, err := del(clientDeleteRequest(c.apiKey, endpoint, opts))
+	res, err := c.requester.del(clientDeleteRequest(endpoint, opts))
 	if err != nil {
 		return nil, fmt.Errorf("del: %w", err)
 	}
+	if emptyBody(res) {
+		return nil, nil
+	}
 	result := new(Client)
-	if err := json.Unmarshal(res, &result); err != nil {
-		if jErr, ok := err.(*json.UnmarshalTypeError); ok {
-			return nil, fmt.Errorf("unmarshal field %v of type %v", jErr.Field, jErr.Type)
-		}
-		return nil, fmt.Errorf("json unmarshal: %w", err)
+	if err := c.requester.unmarshal(res, &result); err != nil {
+		return nil, err
 	}
 	return result, nil
 }
 
-func clientDeleteRequest(apiKey string, endpoint string, options []RequestOption) requestOptions {
+func clientDeleteRequest(endpoint string, options []RequestOption) requestOptions {
 	res := requestOptions{
-		apiKey:   apiKey,
+		resource: ResourceClient,
 		endpoint: endpoint,
 	}
 	injectContext(&res, options)
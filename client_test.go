@@ -32,7 +32,7 @@ func (s *ClientTestSuite) SetupTest() {
 		s.delete()).Methods("DELETE")
 
 	s.server = clientMockServer{
-		baseServer: httptest.NewServer(testMux),
+		baseServer: newJSONServer(testMux),
 	}
 }
 
@@ -183,7 +183,11 @@ func (s *ClientTestSuite) add() func(http.ResponseWriter, *http.Request) {
 	}
 }
 
-var fl = false
+var (
+	fl        = false
+	emptyName = ""
+	dummyName = "Dummy Name"
+)
 
 var testsClientUpdate = []struct {
 	name        string
@@ -202,7 +206,7 @@ var testsClientUpdate = []struct {
 		},
 		wantFields: clientUpdateFields{
 			Archived: nil,
-			Name:     "",
+			Name:     nil,
 		},
 	},
 	{
@@ -219,7 +223,22 @@ var testsClientUpdate = []struct {
 		},
 		wantFields: clientUpdateFields{
 			Archived: &fl,
-			Name:     "Dummy Name",
+			Name:     &dummyName,
+		},
+	},
+	{
+		name:        "Clear name",
+		workspaceID: "Workspace1",
+		clientID:    "3",
+		options: []RequestOption{
+			WithName(""),
+		},
+		wantParams: map[string][]string{
+			"archive-projects": {"false"},
+		},
+		wantFields: clientUpdateFields{
+			Archived: nil,
+			Name:     &emptyName,
 		},
 	},
 }
@@ -270,6 +289,13 @@ var testsClientDelete = []struct {
 		wantStatusCode: http.StatusOK,
 		wantErr:        false,
 	},
+	{
+		name:           "No Content",
+		workspaceID:    "Workspace1",
+		clientID:       "1",
+		wantStatusCode: http.StatusNoContent,
+		wantErr:        false,
+	},
 	{
 		name:           "Not Found",
 		workspaceID:    "Workspace1",
@@ -298,6 +324,9 @@ func (s *ClientTestSuite) delete() func(http.ResponseWriter, *http.Request) {
 		s.Require().Equal(test.clientID, clientID)
 
 		w.WriteHeader(test.wantStatusCode)
+		if test.wantStatusCode == http.StatusNoContent {
+			return
+		}
 		_, err := fmt.Fprintf(w, `{"id":"dummy"}`)
 		s.Require().Nil(err)
 	}
@@ -311,7 +340,7 @@ func (s *ClientTestSuite) TestAll() {
 	for index, tc := range testsClientAll {
 		s.Run(tc.name, func() {
 			s.testIndex = index
-			_, err := glock.Client.All(tc.workspaceID, tc.options...)
+			_, err := glock.Client.All(WorkspaceID(tc.workspaceID), tc.options...)
 			s.Require().Nil(err)
 		})
 	}
@@ -325,7 +354,7 @@ func (s *ClientTestSuite) TestGet() {
 	for index, tc := range testsClientGet {
 		s.Run(tc.name, func() {
 			s.testIndex = index
-			_, err := glock.Client.Get(tc.workspaceID, tc.clientID)
+			_, err := glock.Client.Get(WorkspaceID(tc.workspaceID), ClientID(tc.clientID))
 			if tc.wantErr {
 				s.Require().NotNil(err)
 			} else {
@@ -343,7 +372,7 @@ func (s *ClientTestSuite) TestAdd() {
 	for index, tc := range testsClientAdd {
 		s.Run(tc.name, func() {
 			s.testIndex = index
-			_, err := glock.Client.Add(tc.workspaceID, tc.clientName)
+			_, err := glock.Client.Add(WorkspaceID(tc.workspaceID), tc.clientName)
 			if tc.wantErr {
 				s.Require().NotNil(err)
 			} else {
@@ -361,7 +390,8 @@ func (s *ClientTestSuite) TestUpdate() {
 	for index, tc := range testsClientUpdate {
 		s.Run(tc.name, func() {
 			s.testIndex = index
-			_, err := glock.Client.Update(tc.workspaceID, tc.clientID, tc.options...)
+			_, err := glock.Client.Update(WorkspaceID(tc.workspaceID), ClientID(tc.clientID),
+				tc.options...)
 			s.Require().Nil(err)
 		})
 	}
@@ -375,11 +405,16 @@ func (s *ClientTestSuite) TestDelete() {
 	for index, tc := range testsClientDelete {
 		s.Run(tc.name, func() {
 			s.testIndex = index
-			_, err := glock.Client.Delete(tc.workspaceID, tc.clientID)
-			if tc.wantErr {
+			client, err := glock.Client.Delete(WorkspaceID(tc.workspaceID), ClientID(tc.clientID))
+			switch {
+			case tc.wantErr:
 				s.Require().NotNil(err)
-			} else {
+			case tc.wantStatusCode == http.StatusNoContent:
+				s.Require().Nil(err)
+				s.Require().Nil(client)
+			default:
 				s.Require().Nil(err)
+				s.Require().Equal(ClientID("dummy"), client.ID)
 			}
 		})
 	}
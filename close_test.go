@@ -0,0 +1,70 @@
+package glockify
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/suite"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+type CloseTestSuite struct {
+	suite.Suite
+	server   *httptest.Server
+	started  chan struct{}
+	release  chan struct{}
+	finished chan error
+}
+
+func (s *CloseTestSuite) SetupTest() {
+	s.started = make(chan struct{})
+	s.release = make(chan struct{})
+	s.finished = make(chan error, 1)
+
+	testMux := mux.NewRouter()
+
+	testMux.HandleFunc("/workspaces", func(w http.ResponseWriter, r *http.Request) {
+		close(s.started)
+		<-s.release
+		w.WriteHeader(http.StatusOK)
+		_, err := fmt.Fprintf(w, `[]`)
+		s.Require().Nil(err)
+	})
+
+	s.server = newJSONServer(testMux)
+}
+
+func (s *CloseTestSuite) TearDownTest() {
+	s.server.Close()
+}
+
+func (s *CloseTestSuite) TestDrain() {
+	glock := New(dummyAPIKey, WithEndpoint(Endpoint{
+		Base: s.server.URL,
+	}))
+
+	go func() {
+		_, err := glock.Workspace.All()
+		s.finished <- err
+	}()
+	<-s.started
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	s.Require().True(errors.Is(glock.Close(ctx), context.DeadlineExceeded))
+
+	_, err := glock.Client.All("Workspace1")
+	s.Require().True(errors.Is(err, ErrClosed))
+
+	close(s.release)
+	s.Require().Nil(<-s.finished)
+	s.Require().Nil(glock.Close(context.Background()))
+}
+
+func TestClose(t *testing.T) {
+	suite.Run(t, &CloseTestSuite{})
+}
@@ -0,0 +1,199 @@
+package glockify
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"strings"
+	"sync"
+	"time"
+)
+
+// ErrNoCredential returned by request when Glockify has nil CredentialProvider.
+var ErrNoCredential = errors.New("no credential provider")
+
+// CredentialProvider supply API key used to authenticate request to Clockify.
+// It's consulted on every request, so implementation must be safe for
+// concurrent use. Rotating the key it returns takes effect on the next request.
+type CredentialProvider interface {
+	APIKey(ctx context.Context) (string, error)
+}
+
+// StaticCredential provide API key held in memory. The key can be rotated
+// with Set.
+type StaticCredential struct {
+	mu     sync.RWMutex
+	apiKey string
+}
+
+// NewStaticCredential instantiate StaticCredential with apiKey given.
+func NewStaticCredential(apiKey string) *StaticCredential {
+	return &StaticCredential{apiKey: apiKey}
+}
+
+// APIKey return current API key.
+func (s *StaticCredential) APIKey(context.Context) (string, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return s.apiKey, nil
+}
+
+// Set replace API key returned by this provider.
+func (s *StaticCredential) Set(apiKey string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.apiKey = apiKey
+}
+
+// EnvCredential provide API key from environment variable with given name.
+// The variable is read on every request.
+type EnvCredential string
+
+// APIKey return value of the environment variable.
+func (e EnvCredential) APIKey(context.Context) (string, error) {
+	apiKey := strings.TrimSpace(os.Getenv(string(e)))
+	if apiKey == "" {
+		return "", fmt.Errorf("environment variable %s is empty", string(e))
+	}
+	return apiKey, nil
+}
+
+// FileCredential provide API key stored in a file. The file is re-read
+// whenever its modification time or size changed, so writing new key to it
+// rotates the key without restarting.
+type FileCredential struct {
+	path string
+
+	mu      sync.Mutex
+	apiKey  string
+	modTime time.Time
+	size    int64
+}
+
+// NewFileCredential instantiate FileCredential watching file in path given.
+func NewFileCredential(path string) *FileCredential {
+	return &FileCredential{path: path}
+}
+
+// APIKey return API key from the file, reloading it if the file changed.
+func (f *FileCredential) APIKey(context.Context) (string, error) {
+	info, err := os.Stat(f.path)
+	if err != nil {
+		return "", fmt.Errorf("stat: %w", err)
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if f.apiKey != "" && info.ModTime().Equal(f.modTime) && info.Size() == f.size {
+		return f.apiKey, nil
+	}
+	content, err := ioutil.ReadFile(f.path)
+	if err != nil {
+		return "", fmt.Errorf("read file: %w", err)
+	}
+	apiKey := strings.TrimSpace(string(content))
+	if apiKey == "" {
+		return "", fmt.Errorf("file %s is empty", f.path)
+	}
+	f.apiKey = apiKey
+	f.modTime = info.ModTime()
+	f.size = info.Size()
+
+	return f.apiKey, nil
+}
+
+// CommandCredential provide API key printed to stdout by external command,
+// eg: secret manager CLI. Output is cached for ttl, zero ttl run the command
+// on every request. Concurrent requests share a single run of the command.
+type CommandCredential struct {
+	name string
+	args []string
+	ttl  time.Duration
+
+	mu        sync.Mutex
+	apiKey    string
+	fetchedAt time.Time
+	call      *commandCall
+}
+
+// commandCall is a run of the command in flight, done is closed when it
+// finished.
+type commandCall struct {
+	done   chan struct{}
+	apiKey string
+	err    error
+}
+
+// NewCommandCredential instantiate CommandCredential running command name
+// with args given.
+func NewCommandCredential(ttl time.Duration, name string, args ...string) *CommandCredential {
+	return &CommandCredential{
+		name: name,
+		args: args,
+		ttl:  ttl,
+	}
+}
+
+// APIKey return API key from the command output, running it again when the
+// cached key is expired. The command is not run while holding the lock, and
+// caller waiting for a run started by another request return when ctx is
+// done.
+func (c *CommandCredential) APIKey(ctx context.Context) (string, error) {
+	c.mu.Lock()
+	if c.apiKey != "" && time.Since(c.fetchedAt) < c.ttl {
+		defer c.mu.Unlock()
+		return c.apiKey, nil
+	}
+	call := c.call
+	if call == nil {
+		call = &commandCall{done: make(chan struct{})}
+		c.call = call
+		go c.run(ctx, call)
+	}
+	c.mu.Unlock()
+
+	select {
+	case <-call.done:
+		return call.apiKey, call.err
+	case <-ctx.Done():
+		return "", ctx.Err()
+	}
+}
+
+// run execute the command with ctx of request which started it, and cache
+// its output.
+func (c *CommandCredential) run(ctx context.Context, call *commandCall) {
+	call.apiKey, call.err = c.output(ctx)
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if call.err == nil {
+		c.apiKey = call.apiKey
+		c.fetchedAt = time.Now()
+	}
+	c.call = nil
+	close(call.done)
+}
+
+func (c *CommandCredential) output(ctx context.Context) (string, error) {
+	stderr := new(bytes.Buffer)
+	cmd := exec.CommandContext(ctx, c.name, c.args...)
+	cmd.Stderr = stderr
+	out, err := cmd.Output()
+	if err != nil {
+		return "", fmt.Errorf("run %s: %w: %s", c.name, err, strings.TrimSpace(stderr.String()))
+	}
+	apiKey := strings.TrimSpace(string(out))
+	if apiKey == "" {
+		return "", fmt.Errorf("command %s print empty output", c.name)
+	}
+	return apiKey, nil
+}
@@ -0,0 +1,221 @@
+package glockify
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/suite"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+type CredentialTestSuite struct {
+	suite.Suite
+	server    *httptest.Server
+	gotAPIKey string
+}
+
+func (s *CredentialTestSuite) SetupTest() {
+	testMux := mux.NewRouter()
+
+	testMux.HandleFunc("/workspaces", func(w http.ResponseWriter, r *http.Request) {
+		s.gotAPIKey = r.Header.Get("X-Api-Key")
+		w.WriteHeader(http.StatusOK)
+		_, err := fmt.Fprintf(w, `[]`)
+		s.Require().Nil(err)
+	})
+
+	s.server = newJSONServer(testMux)
+}
+
+func (s *CredentialTestSuite) TearDownTest() {
+	s.server.Close()
+}
+
+func (s *CredentialTestSuite) TestStaticRotation() {
+	credential := NewStaticCredential("key1")
+	glock := New("ignored", WithCredentialProvider(credential), WithEndpoint(Endpoint{
+		Base: s.server.URL,
+	}))
+
+	_, err := glock.Workspace.All()
+	s.Require().Nil(err)
+	s.Require().Equal("key1", s.gotAPIKey)
+
+	credential.Set("key2")
+	_, err = glock.Workspace.All()
+	s.Require().Nil(err)
+	s.Require().Equal("key2", s.gotAPIKey)
+}
+
+func (s *CredentialTestSuite) TestEnv() {
+	const name = "GLOCKIFY_TEST_API_KEY"
+	glock := New("", WithCredentialProvider(EnvCredential(name)), WithEndpoint(Endpoint{
+		Base: s.server.URL,
+	}))
+
+	s.Require().Nil(os.Unsetenv(name))
+	_, err := glock.Workspace.All()
+	s.Require().NotNil(err)
+
+	s.Require().Nil(os.Setenv(name, "env-key"))
+	defer os.Unsetenv(name)
+	_, err = glock.Workspace.All()
+	s.Require().Nil(err)
+	s.Require().Equal("env-key", s.gotAPIKey)
+}
+
+func (s *CredentialTestSuite) TestFileRotation() {
+	path := filepath.Join(s.T().TempDir(), "api-key")
+	s.Require().Nil(ioutil.WriteFile(path, []byte("file-key1\n"), 0600))
+	glock := New("", WithCredentialProvider(NewFileCredential(path)), WithEndpoint(Endpoint{
+		Base: s.server.URL,
+	}))
+
+	_, err := glock.Workspace.All()
+	s.Require().Nil(err)
+	s.Require().Equal("file-key1", s.gotAPIKey)
+
+	s.Require().Nil(ioutil.WriteFile(path, []byte("file-key2\n"), 0600))
+	later := time.Now().Add(time.Second)
+	s.Require().Nil(os.Chtimes(path, later, later))
+	_, err = glock.Workspace.All()
+	s.Require().Nil(err)
+	s.Require().Equal("file-key2", s.gotAPIKey)
+}
+
+// countingCommand return shell script printing "key-N", where N is how many
+// times the script has run, counted in file under dir.
+func countingCommand(dir string, delay string) string {
+	count := filepath.Join(dir, "count")
+	return fmt.Sprintf("sleep %s; echo run >> %s; echo key-$(wc -l < %s | tr -d ' ')", delay,
+		count, count)
+}
+
+func (s *CredentialTestSuite) requireShell() {
+	if _, err := exec.LookPath("sh"); err != nil {
+		s.T().Skip("sh is not available")
+	}
+}
+
+var testsCommandCredential = []struct {
+	name     string
+	ttl      time.Duration
+	wantKeys []string
+}{
+	{
+		name:     "Cached Within TTL",
+		ttl:      time.Hour,
+		wantKeys: []string{"key-1", "key-1", "key-1"},
+	},
+	{
+		name:     "Zero TTL",
+		wantKeys: []string{"key-1", "key-2", "key-3"},
+	},
+}
+
+func (s *CredentialTestSuite) TestCommandCaching() {
+	s.requireShell()
+	for _, tc := range testsCommandCredential {
+		s.Run(tc.name, func() {
+			credential := NewCommandCredential(tc.ttl, "sh", "-c",
+				countingCommand(s.T().TempDir(), "0"))
+			for _, want := range tc.wantKeys {
+				apiKey, err := credential.APIKey(context.Background())
+				s.Require().Nil(err)
+				s.Require().Equal(want, apiKey)
+			}
+		})
+	}
+}
+
+func (s *CredentialTestSuite) TestCommandRequest() {
+	s.requireShell()
+	glock := New("", WithCredentialProvider(NewCommandCredential(time.Hour, "sh", "-c",
+		countingCommand(s.T().TempDir(), "0"))), WithEndpoint(Endpoint{
+		Base: s.server.URL,
+	}))
+
+	_, err := glock.Workspace.All()
+	s.Require().Nil(err)
+	s.Require().Equal("key-1", s.gotAPIKey)
+}
+
+func (s *CredentialTestSuite) TestCommandFailure() {
+	s.requireShell()
+	credential := NewCommandCredential(time.Hour, "sh", "-c", "echo denied >&2; exit 1")
+	_, err := credential.APIKey(context.Background())
+	s.Require().NotNil(err)
+	s.Require().Contains(err.Error(), "denied")
+
+	credential = NewCommandCredential(time.Hour, "sh", "-c", "echo")
+	_, err = credential.APIKey(context.Background())
+	s.Require().NotNil(err)
+}
+
+func (s *CredentialTestSuite) TestCommandConcurrent() {
+	s.requireShell()
+	credential := NewCommandCredential(time.Hour, "sh", "-c",
+		countingCommand(s.T().TempDir(), "0.2"))
+
+	var wg sync.WaitGroup
+	keys := make([]string, 5)
+	for i := range keys {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			apiKey, err := credential.APIKey(context.Background())
+			s.Require().Nil(err)
+			keys[i] = apiKey
+		}(i)
+	}
+	wg.Wait()
+	s.Require().Equal(strings.Repeat("key-1", len(keys)), strings.Join(keys, ""))
+}
+
+func (s *CredentialTestSuite) TestCommandWaitCancelled() {
+	s.requireShell()
+	credential := NewCommandCredential(time.Hour, "sh", "-c",
+		countingCommand(s.T().TempDir(), "1"))
+
+	leader := make(chan string)
+	go func() {
+		apiKey, err := credential.APIKey(context.Background())
+		s.Require().Nil(err)
+		leader <- apiKey
+	}()
+	time.Sleep(100 * time.Millisecond)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	start := time.Now()
+	_, err := credential.APIKey(ctx)
+	s.Require().True(errors.Is(err, context.DeadlineExceeded))
+	s.Require().True(time.Since(start) < 500*time.Millisecond)
+
+	s.Require().Equal("key-1", <-leader)
+	apiKey, err := credential.APIKey(context.Background())
+	s.Require().Nil(err)
+	s.Require().Equal("key-1", apiKey)
+}
+
+func (s *CredentialTestSuite) TestNilProvider() {
+	glock := New("", WithCredentialProvider(nil), WithEndpoint(Endpoint{
+		Base: s.server.URL,
+	}))
+	_, err := glock.Workspace.All()
+	s.Require().True(errors.Is(err, ErrNoCredential))
+}
+
+func TestCredentialProvider(t *testing.T) {
+	suite.Run(t, &CredentialTestSuite{})
+}
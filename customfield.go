@@ -0,0 +1,677 @@
+package glockify
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"net/url"
+	"strconv"
+)
+
+// CustomFieldType is type of custom field value.
+type CustomFieldType string
+
+// Possible values of CustomFieldType
+const (
+	CustomFieldTypeText             CustomFieldType = "TXT"
+	CustomFieldTypeNumber           CustomFieldType = "NUMBER"
+	CustomFieldTypeDropdownSingle   CustomFieldType = "DROPDOWN_SINGLE"
+	CustomFieldTypeDropdownMultiple CustomFieldType = "DROPDOWN_MULTIPLE"
+	CustomFieldTypeCheckbox         CustomFieldType = "CHECKBOX"
+	CustomFieldTypeLink             CustomFieldType = "LINK"
+	CustomFieldTypeUnknown          CustomFieldType = enumUnknown
+)
+
+// UnmarshalJSON decode CustomFieldType, unknown value decode into
+// CustomFieldTypeUnknown.
+func (c *CustomFieldType) UnmarshalJSON(data []byte) error {
+	v, err := unmarshalEnum(data, string(CustomFieldTypeUnknown), string(CustomFieldTypeText),
+		string(CustomFieldTypeNumber), string(CustomFieldTypeDropdownSingle),
+		string(CustomFieldTypeDropdownMultiple), string(CustomFieldTypeCheckbox),
+		string(CustomFieldTypeLink))
+	*c = CustomFieldType(v)
+	return err
+}
+
+// Possible errors when accessing custom field value.
+var (
+	ErrCustomFieldType  = errors.New("custom field type mismatch")
+	ErrCustomFieldValue = errors.New("invalid custom field value")
+)
+
+// CustomFieldValue is value of custom field along with its type. Use
+// constructors such as TextValue to build it.
+type CustomFieldValue struct {
+	Type  CustomFieldType
+	Value interface{}
+}
+
+// TextValue instantiate CustomFieldValue of CustomFieldTypeText.
+func TextValue(v string) CustomFieldValue {
+	return CustomFieldValue{Type: CustomFieldTypeText, Value: v}
+}
+
+// NumberValue instantiate CustomFieldValue of CustomFieldTypeNumber.
+func NumberValue(v float64) CustomFieldValue {
+	return CustomFieldValue{Type: CustomFieldTypeNumber, Value: v}
+}
+
+// DropdownSingleValue instantiate CustomFieldValue of
+// CustomFieldTypeDropdownSingle.
+func DropdownSingleValue(v string) CustomFieldValue {
+	return CustomFieldValue{Type: CustomFieldTypeDropdownSingle, Value: v}
+}
+
+// DropdownMultipleValue instantiate CustomFieldValue of
+// CustomFieldTypeDropdownMultiple.
+func DropdownMultipleValue(v ...string) CustomFieldValue {
+	if v == nil {
+		v = []string{}
+	}
+	return CustomFieldValue{Type: CustomFieldTypeDropdownMultiple, Value: v}
+}
+
+// CheckboxValue instantiate CustomFieldValue of CustomFieldTypeCheckbox.
+func CheckboxValue(v bool) CustomFieldValue {
+	return CustomFieldValue{Type: CustomFieldTypeCheckbox, Value: v}
+}
+
+// LinkValue instantiate CustomFieldValue of CustomFieldTypeLink.
+func LinkValue(v string) CustomFieldValue {
+	return CustomFieldValue{Type: CustomFieldTypeLink, Value: v}
+}
+
+// Validate check whether Value is valid for its Type. Nil Value is valid,
+// since it means the field is not set.
+func (c CustomFieldValue) Validate() error {
+	if c.Value == nil {
+		return nil
+	}
+	var err error
+	switch c.Type {
+	case CustomFieldTypeText, CustomFieldTypeDropdownSingle:
+		_, err = customFieldString(c.Value)
+	case CustomFieldTypeNumber:
+		_, err = customFieldNumber(c.Value)
+	case CustomFieldTypeDropdownMultiple:
+		_, err = customFieldStrings(c.Value)
+	case CustomFieldTypeCheckbox:
+		_, err = customFieldBool(c.Value)
+	case CustomFieldTypeLink:
+		_, err = customFieldLink(c.Value)
+	default:
+		err = fmt.Errorf("%w: unknown type %s", ErrCustomFieldType, c.Type)
+	}
+	return err
+}
+
+// Typed return value of custom field along with its type.
+func (c CustomFields) Typed() CustomFieldValue {
+	return CustomFieldValue{Type: c.Type, Value: c.Value}
+}
+
+// Validate check whether Value is valid for custom field Type.
+func (c CustomFields) Validate() error {
+	if err := c.Typed().Validate(); err != nil {
+		return fmt.Errorf("custom field %s: %w", c.Name, err)
+	}
+	return nil
+}
+
+// SetValue set custom field Value after validating it against custom field Type.
+func (c *CustomFields) SetValue(v CustomFieldValue) error {
+	if v.Type != c.Type {
+		return fmt.Errorf("%w: field %s is %s, got %s", ErrCustomFieldType, c.Name, c.Type,
+			v.Type)
+	}
+	if err := v.Validate(); err != nil {
+		return fmt.Errorf("custom field %s: %w", c.Name, err)
+	}
+	c.Value = v.Value
+	return nil
+}
+
+func (c CustomFields) checkType(t CustomFieldType) error {
+	if c.Type == t {
+		return nil
+	}
+	return fmt.Errorf("%w: field %s is %s", ErrCustomFieldType, c.Name, c.Type)
+}
+
+// Text return value of CustomFieldTypeText field.
+func (c CustomFields) Text() (string, error) {
+	if err := c.checkType(CustomFieldTypeText); err != nil {
+		return "", err
+	}
+	return customFieldString(c.Value)
+}
+
+// Number return value of CustomFieldTypeNumber field.
+func (c CustomFields) Number() (float64, error) {
+	if err := c.checkType(CustomFieldTypeNumber); err != nil {
+		return 0, err
+	}
+	return customFieldNumber(c.Value)
+}
+
+// DropdownSingle return selected option of CustomFieldTypeDropdownSingle field.
+func (c CustomFields) DropdownSingle() (string, error) {
+	if err := c.checkType(CustomFieldTypeDropdownSingle); err != nil {
+		return "", err
+	}
+	return customFieldString(c.Value)
+}
+
+// DropdownMultiple return selected options of CustomFieldTypeDropdownMultiple
+// field.
+func (c CustomFields) DropdownMultiple() ([]string, error) {
+	if err := c.checkType(CustomFieldTypeDropdownMultiple); err != nil {
+		return nil, err
+	}
+	return customFieldStrings(c.Value)
+}
+
+// Checkbox return value of CustomFieldTypeCheckbox field.
+func (c CustomFields) Checkbox() (bool, error) {
+	if err := c.checkType(CustomFieldTypeCheckbox); err != nil {
+		return false, err
+	}
+	return customFieldBool(c.Value)
+}
+
+// Link return value of CustomFieldTypeLink field.
+func (c CustomFields) Link() (*url.URL, error) {
+	if err := c.checkType(CustomFieldTypeLink); err != nil {
+		return nil, err
+	}
+	return customFieldLink(c.Value)
+}
+
+func customFieldString(v interface{}) (string, error) {
+	switch val := v.(type) {
+	case nil:
+		return "", nil
+	case string:
+		return val, nil
+	}
+	return "", fmt.Errorf("%w: %v is not a string", ErrCustomFieldValue, v)
+}
+
+func customFieldNumber(v interface{}) (float64, error) {
+	switch val := v.(type) {
+	case nil:
+		return 0, nil
+	case float64:
+		return val, nil
+	case int:
+		return float64(val), nil
+	case string:
+		if val == "" {
+			return 0, nil
+		}
+		f, err := strconv.ParseFloat(val, 64)
+		if err == nil {
+			return f, nil
+		}
+	}
+	return 0, fmt.Errorf("%w: %v is not a number", ErrCustomFieldValue, v)
+}
+
+func customFieldStrings(v interface{}) ([]string, error) {
+	switch val := v.(type) {
+	case nil:
+		return nil, nil
+	case []string:
+		return val, nil
+	case string:
+		if val == "" {
+			return nil, nil
+		}
+		return []string{val}, nil
+	case []interface{}:
+		res := make([]string, 0, len(val))
+		for _, item := range val {
+			s, ok := item.(string)
+			if !ok {
+				return nil, fmt.Errorf("%w: %v is not a string", ErrCustomFieldValue, item)
+			}
+			res = append(res, s)
+		}
+		return res, nil
+	}
+	return nil, fmt.Errorf("%w: %v is not a list of string", ErrCustomFieldValue, v)
+}
+
+func customFieldBool(v interface{}) (bool, error) {
+	switch val := v.(type) {
+	case nil:
+		return false, nil
+	case bool:
+		return val, nil
+	case string:
+		if val == "" {
+			return false, nil
+		}
+		b, err := strconv.ParseBool(val)
+		if err == nil {
+			return b, nil
+		}
+	}
+	return false, fmt.Errorf("%w: %v is not a boolean", ErrCustomFieldValue, v)
+}
+
+func customFieldLink(v interface{}) (*url.URL, error) {
+	s, err := customFieldString(v)
+	if err != nil {
+		return nil, err
+	}
+	if s == "" {
+		return nil, nil
+	}
+	u, err := url.Parse(s)
+	if err != nil || u.Scheme == "" || u.Host == "" {
+		return nil, fmt.Errorf("%w: %q is not an absolute URL", ErrCustomFieldValue, s)
+	}
+	return u, nil
+}
+
+// CustomFieldNode manipulating CustomField resource.
+type CustomFieldNode struct {
+	endpoint  string
+	requester *requester
+}
+
+// CustomField represent Clockify's workspace custom field definition. Value of
+// the field on project is represented by CustomFields.
+// See: https://clockify.me/developers-api#tag-Custom-fields
+type CustomField struct {
+	ID                    CustomFieldID         `json:"id,omitempty"`
+	Name                  string                `json:"name,omitempty"`
+	Type                  CustomFieldType       `json:"type,omitempty"`
+	Description           string                `json:"description,omitempty"`
+	Placeholder           string                `json:"placeholder,omitempty"`
+	AllowedValues         []string              `json:"allowedValues,omitempty"`
+	Required              bool                  `json:"required,omitempty"`
+	OnlyAdminCanEdit      bool                  `json:"onlyAdminCanEdit,omitempty"`
+	Status                CustomFieldStatus     `json:"status,omitempty"`
+	EntityType            CustomFieldEntityType `json:"entityType,omitempty"`
+	WorkspaceDefaultValue interface{}           `json:"workspaceDefaultValue,omitempty"`
+	WorkspaceID           WorkspaceID           `json:"workspaceId,omitempty"`
+
+	// Extra holds JSON properties unknown to this package.
+	// See: WithPreserveUnknownFields.
+	Extra map[string]json.RawMessage `json:"-"`
+}
+
+// DefaultValue return workspace default value along with its type.
+func (c CustomField) DefaultValue() CustomFieldValue {
+	return CustomFieldValue{Type: c.Type, Value: c.WorkspaceDefaultValue}
+}
+
+// CustomFieldEntityType is kind of resource custom field is placed on.
+type CustomFieldEntityType string
+
+// Possible values of CustomFieldEntityType
+const (
+	CustomFieldEntityTypeTimeEntry CustomFieldEntityType = "TIMEENTRY"
+	CustomFieldEntityTypeUser      CustomFieldEntityType = "USER"
+	CustomFieldEntityTypeUnknown   CustomFieldEntityType = enumUnknown
+)
+
+// UnmarshalJSON decode CustomFieldEntityType, unknown value decode into
+// CustomFieldEntityTypeUnknown.
+func (c *CustomFieldEntityType) UnmarshalJSON(data []byte) error {
+	v, err := unmarshalEnum(data, string(CustomFieldEntityTypeUnknown),
+		string(CustomFieldEntityTypeTimeEntry), string(CustomFieldEntityTypeUser))
+	*c = CustomFieldEntityType(v)
+	return err
+}
+
+const (
+	allowedValuesKey    = "allowedValues"
+	placeholderKey      = "placeholder"
+	requiredKey         = "required"
+	onlyAdminCanEditKey = "onlyAdminCanEdit"
+	defaultValueKey     = "defaultValue"
+	defaultValueTypeKey = "defaultValueType"
+	entityTypeKey       = "entity-type"
+)
+
+// WithAllowedValues set options of dropdown custom field.
+func WithAllowedValues(values []string) RequestOption {
+	return RequestOption{
+		paramsProvider: func(v url.Values) string {
+			for _, value := range values {
+				v.Add(allowedValuesKey, value)
+			}
+			return allowedValuesKey
+		},
+	}
+}
+
+// WithPlaceholder set custom field's placeholder.
+func WithPlaceholder(placeholder string) RequestOption {
+	return RequestOption{
+		paramsProvider: func(v url.Values) string {
+			v.Set(placeholderKey, placeholder)
+			return placeholderKey
+		},
+	}
+}
+
+// WithRequired set whether custom field must be filled.
+func WithRequired(required bool) RequestOption {
+	return RequestOption{
+		paramsProvider: func(v url.Values) string {
+			v.Set(requiredKey, strconv.FormatBool(required))
+			return requiredKey
+		},
+	}
+}
+
+// WithOnlyAdminCanEdit set whether only admin can edit custom field's value.
+func WithOnlyAdminCanEdit(onlyAdmin bool) RequestOption {
+	return RequestOption{
+		paramsProvider: func(v url.Values) string {
+			v.Set(onlyAdminCanEditKey, strconv.FormatBool(onlyAdmin))
+			return onlyAdminCanEditKey
+		},
+	}
+}
+
+// WithCustomFieldStatus when applied to CustomFieldNode.All, filter custom
+// fields by status. Otherwise it's set where custom field is placed:
+// CustomFieldStatusVisible shows it on time entries, CustomFieldStatusInvisible
+// hides it, and CustomFieldStatusInactive disables it.
+func WithCustomFieldStatus(status CustomFieldStatus) RequestOption {
+	return RequestOption{
+		paramsProvider: func(v url.Values) string {
+			v.Set(statusKey, string(status))
+			return statusKey
+		},
+	}
+}
+
+// WithEntityType filter custom fields by kind of resource they're placed on.
+func WithEntityType(entityType CustomFieldEntityType) RequestOption {
+	return RequestOption{
+		paramsProvider: func(v url.Values) string {
+			v.Set(entityTypeKey, string(entityType))
+			return entityTypeKey
+		},
+	}
+}
+
+// WithDefaultValue set custom field's workspace default value. Value is
+// validated against its type by Add and Update before sending the request.
+func WithDefaultValue(value CustomFieldValue) RequestOption {
+	return RequestOption{
+		paramsProvider: func(v url.Values) string {
+			d, err := json.Marshal(value.Value)
+			if err != nil {
+				log.Fatalf("%v", err)
+			}
+			v.Set(defaultValueKey, string(d))
+			v.Set(defaultValueTypeKey, string(value.Type))
+			return defaultValueKey
+		},
+	}
+}
+
+type customFieldFields struct {
+	Name                  *string            `json:"name,omitempty"`
+	Type                  CustomFieldType    `json:"type,omitempty"`
+	Description           *string            `json:"description,omitempty"`
+	Placeholder           *string            `json:"placeholder,omitempty"`
+	AllowedValues         *[]string          `json:"allowedValues,omitempty"`
+	Required              *bool              `json:"required,omitempty"`
+	OnlyAdminCanEdit      *bool              `json:"onlyAdminCanEdit,omitempty"`
+	Status                *CustomFieldStatus `json:"status,omitempty"`
+	WorkspaceDefaultValue json.RawMessage    `json:"workspaceDefaultValue,omitempty"`
+}
+
+type projectCustomFieldFields struct {
+	DefaultValue interface{}        `json:"defaultValue"`
+	Status       *CustomFieldStatus `json:"status,omitempty"`
+}
+
+// All get all CustomField of workspace based on filter given, eg: WithName,
+// WithCustomFieldStatus and WithEntityType.
+func (c *CustomFieldNode) All(workspaceID WorkspaceID, opts ...RequestOption) ([]CustomField,
+	error) {
+	endpoint := fmt.Sprintf("%s/workspaces/%s/custom-fields", c.endpoint, workspaceID)
+	res, err := c.requester.get(customFieldAllRequest(endpoint, opts))
+	if err != nil {
+		return nil, fmt.Errorf("get: %w", err)
+	}
+	result := make([]CustomField, 0)
+	if err := c.requester.unmarshal(res, &result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func customFieldAllRequest(endpoint string, options []RequestOption) requestOptions {
+	res := requestOptions{
+		resource:  ResourceCustomField,
+		operation: OperationList,
+		endpoint:  endpoint,
+	}
+	res.params = url.Values{}
+	for _, opt := range options {
+		if opt.paramsProvider != nil {
+			opt.paramsProvider(res.params)
+		}
+	}
+	injectContext(&res, options)
+
+	return res
+}
+
+// Add create new CustomField of type given, based on options such as
+// WithAllowedValues, WithRequired, WithCustomFieldStatus and WithDefaultValue.
+// Default value must be of the type given.
+func (c *CustomFieldNode) Add(workspaceID WorkspaceID, name string, fieldType CustomFieldType,
+	opts ...RequestOption) (*CustomField, error) {
+	value, ok, err := customFieldDefaultValue(opts)
+	if err != nil {
+		return nil, fmt.Errorf("custom field %s: %w", name, err)
+	}
+	if ok && value.Type != fieldType {
+		return nil, fmt.Errorf("%w: field %s is %s, got %s", ErrCustomFieldType, name, fieldType,
+			value.Type)
+	}
+	endpoint := fmt.Sprintf("%s/workspaces/%s/custom-fields", c.endpoint, workspaceID)
+	req := customFieldWriteRequest(endpoint, opts)
+	fields := req.fields.(customFieldFields)
+	fields.Name = &name
+	fields.Type = fieldType
+	req.fields = fields
+
+	res, err := c.requester.post(req)
+	if err != nil {
+		return nil, fmt.Errorf("post: %w", err)
+	}
+	result := new(CustomField)
+	if err := c.requester.unmarshal(res, &result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// Update existing CustomField based on options given. Only fields set by
+// options are sent, so fields not given are left unchanged.
+func (c *CustomFieldNode) Update(workspaceID WorkspaceID, id CustomFieldID,
+	opts ...RequestOption) (*CustomField, error) {
+	if _, _, err := customFieldDefaultValue(opts); err != nil {
+		return nil, fmt.Errorf("custom field %s: %w", id, err)
+	}
+	endpoint := fmt.Sprintf("%s/workspaces/%s/custom-fields/%s", c.endpoint, workspaceID, id)
+	res, err := c.requester.put(customFieldWriteRequest(endpoint, opts))
+	if err != nil {
+		return nil, fmt.Errorf("put: %w", err)
+	}
+	result := new(CustomField)
+	if err := c.requester.unmarshal(res, &result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// customFieldDefaultValue return default value given by WithDefaultValue after
+// validating it against its type, and report whether it is given.
+func customFieldDefaultValue(options []RequestOption) (CustomFieldValue, bool, error) {
+	for _, opt := range options {
+		if opt.paramsProvider == nil {
+			continue
+		}
+		params := url.Values{}
+		if key := opt.paramsProvider(params); key != defaultValueKey {
+			continue
+		}
+		value := CustomFieldValue{Type: CustomFieldType(params.Get(defaultValueTypeKey))}
+		if err := json.Unmarshal([]byte(params.Get(defaultValueKey)), &value.Value); err != nil {
+			return value, true, fmt.Errorf("json unmarshal: %w", err)
+		}
+		return value, true, value.Validate()
+	}
+	return CustomFieldValue{}, false, nil
+}
+
+func customFieldWriteRequest(endpoint string, options []RequestOption) requestOptions {
+	res := requestOptions{
+		resource: ResourceCustomField,
+		endpoint: endpoint,
+	}
+
+	fields := customFieldFields{}
+	for _, opt := range options {
+		if opt.paramsProvider != nil {
+			params := url.Values{}
+			key := opt.paramsProvider(params)
+			switch key {
+			case nameKey:
+				val := params.Get(key)
+				fields.Name = &val
+			case descriptionKey:
+				val := params.Get(key)
+				fields.Description = &val
+			case placeholderKey:
+				val := params.Get(key)
+				fields.Placeholder = &val
+			case allowedValuesKey:
+				val := params[key]
+				if val == nil {
+					val = []string{}
+				}
+				fields.AllowedValues = &val
+			case requiredKey:
+				val, _ := strconv.ParseBool(params.Get(key))
+				fields.Required = &val
+			case onlyAdminCanEditKey:
+				val, _ := strconv.ParseBool(params.Get(key))
+				fields.OnlyAdminCanEdit = &val
+			case statusKey:
+				val := CustomFieldStatus(params.Get(key))
+				fields.Status = &val
+			case defaultValueKey:
+				fields.WorkspaceDefaultValue = json.RawMessage(params.Get(key))
+			}
+		}
+	}
+	res.fields = fields
+	injectContext(&res, options)
+
+	return res
+}
+
+// Delete existing CustomField, and return the deleted CustomField. Nil CustomField
+// is returned when Clockify respond without body.
+func (c *CustomFieldNode) Delete(workspaceID WorkspaceID, id CustomFieldID,
+	opts ...RequestOption) (*CustomField, error) {
+	endpoint := fmt.Sprintf("%s/workspaces/%s/custom-fields/%s", c.endpoint, workspaceID, id)
+	res, err := c.requester.del(customFieldDeleteRequest(endpoint, opts))
+	if err != nil {
+		return nil, fmt.Errorf("del: %w", err)
+	}
+	if emptyBody(res) {
+		return nil, nil
+	}
+	result := new(CustomField)
+	if err := c.requester.unmarshal(res, &result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func customFieldDeleteRequest(endpoint string, options []RequestOption) requestOptions {
+	res := requestOptions{
+		resource: ResourceCustomField,
+		endpoint: endpoint,
+	}
+	injectContext(&res, options)
+
+	return res
+}
+
+// ProjectAll get custom fields of project along with their project default
+// value.
+func (c *CustomFieldNode) ProjectAll(workspaceID WorkspaceID, projectID ProjectID,
+	opts ...RequestOption) ([]CustomFields, error) {
+	endpoint := fmt.Sprintf("%s/workspaces/%s/projects/%s/custom-fields", c.endpoint,
+		workspaceID, projectID)
+	res, err := c.requester.get(customFieldAllRequest(endpoint, opts))
+	if err != nil {
+		return nil, fmt.Errorf("get: %w", err)
+	}
+	result := make([]CustomFields, 0)
+	if err := c.requester.unmarshal(res, &result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// UpdateProjectDefault set default value of custom field on project, after
+// validating value against its type. WithCustomFieldStatus may be given to
+// change placement of the field on project.
+func (c *CustomFieldNode) UpdateProjectDefault(workspaceID WorkspaceID, projectID ProjectID,
+	id CustomFieldID, value CustomFieldValue, opts ...RequestOption) (*CustomFields, error) {
+	if err := value.Validate(); err != nil {
+		return nil, fmt.Errorf("custom field %s: %w", id, err)
+	}
+	endpoint := fmt.Sprintf("%s/workspaces/%s/projects/%s/custom-fields/%s", c.endpoint,
+		workspaceID, projectID, id)
+	res, err := c.requester.patch(customFieldUpdateProjectDefaultRequest(endpoint, value, opts))
+	if err != nil {
+		return nil, fmt.Errorf("patch: %w", err)
+	}
+	result := new(CustomFields)
+	if err := c.requester.unmarshal(res, &result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func customFieldUpdateProjectDefaultRequest(endpoint string, value CustomFieldValue,
+	options []RequestOption) requestOptions {
+	res := requestOptions{
+		resource: ResourceCustomField,
+		endpoint: endpoint,
+	}
+
+	fields := projectCustomFieldFields{DefaultValue: value.Value}
+	for _, opt := range options {
+		if opt.paramsProvider != nil {
+			params := url.Values{}
+			if key := opt.paramsProvider(params); key == statusKey {
+				val := CustomFieldStatus(params.Get(key))
+				fields.Status = &val
+			}
+		}
+	}
+	res.fields = fields
+	injectContext(&res, options)
+
+	return res
+}
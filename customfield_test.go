@@ -0,0 +1,215 @@
+package glockify
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/suite"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+type CustomFieldTestSuite struct {
+	suite.Suite
+}
+
+const hydratedProjectJSON = `{
+	"id": "Project1",
+	"customFields": [
+		{"customFieldId": "1", "name": "Ticket", "type": "TXT", "value": "ABC-1"},
+		{"customFieldId": "2", "name": "Budget", "type": "NUMBER", "value": 12.5},
+		{"customFieldId": "3", "name": "Stage", "type": "DROPDOWN_SINGLE", "value": "Design"},
+		{"customFieldId": "4", "name": "Teams", "type": "DROPDOWN_MULTIPLE", "value": ["A", "B"]},
+		{"customFieldId": "5", "name": "Internal", "type": "CHECKBOX", "value": true},
+		{"customFieldId": "6", "name": "Board", "type": "LINK", "value": "https://example.com/b"}
+	]
+}`
+
+func (s *CustomFieldTestSuite) TestAccessors() {
+	project := Project{}
+	s.Require().Nil(json.Unmarshal([]byte(hydratedProjectJSON), &project))
+	for _, c := range project.CustomFields {
+		s.Require().Nil(c.Validate())
+	}
+
+	field, ok := project.CustomField("Ticket")
+	s.Require().True(ok)
+	text, err := field.Text()
+	s.Require().Nil(err)
+	s.Require().Equal("ABC-1", text)
+	_, err = field.Number()
+	s.Require().True(errors.Is(err, ErrCustomFieldType))
+
+	field, _ = project.CustomField("2")
+	number, err := field.Number()
+	s.Require().Nil(err)
+	s.Require().Equal(12.5, number)
+
+	field, _ = project.CustomField("Stage")
+	stage, err := field.DropdownSingle()
+	s.Require().Nil(err)
+	s.Require().Equal("Design", stage)
+
+	field, _ = project.CustomField("Teams")
+	teams, err := field.DropdownMultiple()
+	s.Require().Nil(err)
+	s.Require().Equal([]string{"A", "B"}, teams)
+
+	field, _ = project.CustomField("Internal")
+	internal, err := field.Checkbox()
+	s.Require().Nil(err)
+	s.Require().True(internal)
+
+	field, _ = project.CustomField("Board")
+	link, err := field.Link()
+	s.Require().Nil(err)
+	s.Require().Equal("example.com", link.Host)
+
+	_, ok = project.CustomField("Missing")
+	s.Require().False(ok)
+}
+
+func (s *CustomFieldTestSuite) TestSetValue() {
+	field := CustomFields{Name: "Budget", Type: CustomFieldTypeNumber}
+	s.Require().Nil(field.SetValue(NumberValue(10)))
+	s.Require().Equal(10.0, field.Value)
+
+	err := field.SetValue(TextValue("10"))
+	s.Require().True(errors.Is(err, ErrCustomFieldType))
+
+	field = CustomFields{Name: "Board", Type: CustomFieldTypeLink}
+	err = field.SetValue(LinkValue("not a link"))
+	s.Require().True(errors.Is(err, ErrCustomFieldValue))
+
+	field = CustomFields{Name: "Internal", Type: CustomFieldTypeCheckbox, Value: "yes"}
+	s.Require().True(errors.Is(field.Validate(), ErrCustomFieldValue))
+}
+
+func (s *CustomFieldTestSuite) server(wantBody string) *httptest.Server {
+	testMux := mux.NewRouter()
+	testMux.HandleFunc("/workspaces/{workspaceID}/custom-fields",
+		func(w http.ResponseWriter, r *http.Request) {
+			s.Require().True(checkAuthHeader(r))
+			s.Require().Equal("VISIBLE", r.URL.Query().Get("status"))
+			s.Require().Equal("TIMEENTRY", r.URL.Query().Get("entity-type"))
+			_, err := fmt.Fprint(w, `[{"id":"Field1","name":"Stage","type":"DROPDOWN_SINGLE",`+
+				`"allowedValues":["Design","Build"],"workspaceDefaultValue":"Design",`+
+				`"entityType":"TIMEENTRY","status":"VISIBLE"}]`)
+			s.Require().Nil(err)
+		}).Methods("GET")
+	write := func(w http.ResponseWriter, r *http.Request) {
+		s.Require().True(checkAuthHeader(r))
+		body, err := ioutil.ReadAll(r.Body)
+		s.Require().Nil(err)
+		s.Require().JSONEq(wantBody, string(body))
+		if r.Method == http.MethodPost {
+			w.WriteHeader(http.StatusCreated)
+		}
+		_, err = fmt.Fprint(w, `{"id":"Field1","name":"Stage","type":"DROPDOWN_SINGLE"}`)
+		s.Require().Nil(err)
+	}
+	testMux.HandleFunc("/workspaces/{workspaceID}/custom-fields", write).Methods("POST")
+	testMux.HandleFunc("/workspaces/{workspaceID}/custom-fields/{id}", write).Methods("PUT")
+	testMux.HandleFunc("/workspaces/{workspaceID}/custom-fields/{id}",
+		func(w http.ResponseWriter, r *http.Request) {
+			s.Require().True(checkAuthHeader(r))
+			if mux.Vars(r)["id"] != "Field1" {
+				w.WriteHeader(http.StatusNoContent)
+				return
+			}
+			_, err := fmt.Fprint(w, `{"id":"Field1","name":"Stage","type":"DROPDOWN_SINGLE"}`)
+			s.Require().Nil(err)
+		}).Methods("DELETE")
+	testMux.HandleFunc("/workspaces/{workspaceID}/projects/{projectID}/custom-fields/{id}",
+		func(w http.ResponseWriter, r *http.Request) {
+			body, err := ioutil.ReadAll(r.Body)
+			s.Require().Nil(err)
+			s.Require().JSONEq(wantBody, string(body))
+			_, err = fmt.Fprint(w, `{"customFieldId":"Field1","name":"Teams",`+
+				`"type":"DROPDOWN_MULTIPLE","value":["A","B"],"status":"VISIBLE"}`)
+			s.Require().Nil(err)
+		}).Methods("PATCH")
+	return newJSONServer(testMux)
+}
+
+func (s *CustomFieldTestSuite) TestNodeAll() {
+	server := s.server("")
+	defer server.Close()
+	glock := New(dummyAPIKey, WithEndpoint(Endpoint{Base: server.URL}))
+
+	fields, err := glock.CustomField.All("Workspace1",
+		WithCustomFieldStatus(CustomFieldStatusVisible),
+		WithEntityType(CustomFieldEntityTypeTimeEntry))
+	s.Require().Nil(err)
+	s.Require().Equal(CustomFieldID("Field1"), fields[0].ID)
+	s.Require().Equal([]string{"Design", "Build"}, fields[0].AllowedValues)
+	s.Require().Nil(fields[0].DefaultValue().Validate())
+}
+
+func (s *CustomFieldTestSuite) TestNodeAdd() {
+	server := s.server(`{"name":"Stage","type":"DROPDOWN_SINGLE",` +
+		`"allowedValues":["Design","Build"],"required":true,"status":"VISIBLE",` +
+		`"workspaceDefaultValue":"Design"}`)
+	defer server.Close()
+	glock := New(dummyAPIKey, WithEndpoint(Endpoint{Base: server.URL}))
+
+	field, err := glock.CustomField.Add("Workspace1", "Stage", CustomFieldTypeDropdownSingle,
+		WithAllowedValues([]string{"Design", "Build"}),
+		WithRequired(true),
+		WithCustomFieldStatus(CustomFieldStatusVisible),
+		WithDefaultValue(DropdownSingleValue("Design")),
+	)
+	s.Require().Nil(err)
+	s.Require().Equal(CustomFieldTypeDropdownSingle, field.Type)
+
+	_, err = glock.CustomField.Add("Workspace1", "Stage", CustomFieldTypeDropdownSingle,
+		WithDefaultValue(CheckboxValue(true)))
+	s.Require().True(errors.Is(err, ErrCustomFieldType))
+	_, err = glock.CustomField.Add("Workspace1", "Count", CustomFieldTypeNumber,
+		WithDefaultValue(CustomFieldValue{Type: CustomFieldTypeNumber, Value: "many"}))
+	s.Require().True(errors.Is(err, ErrCustomFieldValue))
+}
+
+func (s *CustomFieldTestSuite) TestNodeUpdate() {
+	server := s.server(`{"placeholder":"Pick one","onlyAdminCanEdit":false}`)
+	defer server.Close()
+	glock := New(dummyAPIKey, WithEndpoint(Endpoint{Base: server.URL}))
+
+	_, err := glock.CustomField.Update("Workspace1", "Field1", WithPlaceholder("Pick one"),
+		WithOnlyAdminCanEdit(false))
+	s.Require().Nil(err)
+	_, err = glock.CustomField.Update("Workspace1", "Field1",
+		WithDefaultValue(CustomFieldValue{Type: CustomFieldTypeCheckbox, Value: "yes"}))
+	s.Require().True(errors.Is(err, ErrCustomFieldValue))
+
+	field, err := glock.CustomField.Delete("Workspace1", "Field1")
+	s.Require().Nil(err)
+	s.Require().Equal(CustomFieldID("Field1"), field.ID)
+	field, err = glock.CustomField.Delete("Workspace1", "Field2")
+	s.Require().Nil(err)
+	s.Require().Nil(field)
+}
+
+func (s *CustomFieldTestSuite) TestNodeUpdateProjectDefault() {
+	server := s.server(`{"defaultValue":["A","B"],"status":"VISIBLE"}`)
+	defer server.Close()
+	glock := New(dummyAPIKey, WithEndpoint(Endpoint{Base: server.URL}))
+
+	field, err := glock.CustomField.UpdateProjectDefault("Workspace1", "Project1", "Field1",
+		DropdownMultipleValue("A", "B"), WithCustomFieldStatus(CustomFieldStatusVisible))
+	s.Require().Nil(err)
+	teams, err := field.DropdownMultiple()
+	s.Require().Nil(err)
+	s.Require().Equal([]string{"A", "B"}, teams)
+
+	_, err = glock.CustomField.UpdateProjectDefault("Workspace1", "Project1", "Field1",
+		CustomFieldValue{Type: CustomFieldTypeNumber, Value: "many"})
+	s.Require().True(errors.Is(err, ErrCustomFieldValue))
+}
+
+func TestCustomField(t *testing.T) {
+	suite.Run(t, &CustomFieldTestSuite{})
+}
@@ -0,0 +1,119 @@
+package glockify
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+const redactedAPIKey = "[REDACTED]"
+
+// debugger write HTTP exchanges to writer for troubleshooting.
+type debugger struct {
+	mu        sync.Mutex
+	writer    io.Writer
+	resources map[Resource]bool
+}
+
+// WithDebug write every HTTP exchange made by Glockify to w: method, URL,
+// query, headers with API key redacted, JSON body, response status, response
+// body and timing. If resources given, only request to those resources
+// are written.
+func WithDebug(w io.Writer, resources ...Resource) Option {
+	return func(g *Glockify) {
+		d := &debugger{writer: w}
+		if len(resources) > 0 {
+			d.resources = make(map[Resource]bool, len(resources))
+			for _, resource := range resources {
+				d.resources[resource] = true
+			}
+		}
+		g.debug = d
+	}
+}
+
+func (d *debugger) enabled(resource Resource) bool {
+	if d == nil || d.writer == nil {
+		return false
+	}
+	return d.resources == nil || d.resources[resource]
+}
+
+func (d *debugger) dump(req *http.Request, reqBody []byte, statusCode int, respBody []byte,
+	elapsed time.Duration, err error) {
+	buf := new(bytes.Buffer)
+
+	fmt.Fprintf(buf, "--> %s %s\n", req.Method, req.URL.String())
+	if query := req.URL.Query(); len(query) > 0 {
+		buf.WriteString("Query:\n")
+		keys := make([]string, 0, len(query))
+		for key := range query {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		for _, key := range keys {
+			fmt.Fprintf(buf, "  %s: %s\n", key, strings.Join(query[key], ", "))
+		}
+	}
+	buf.WriteString("Headers:\n")
+	keys := make([]string, 0, len(req.Header))
+	for key := range req.Header {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		value := strings.Join(req.Header[key], ", ")
+		if http.CanonicalHeaderKey(key) == "X-Api-Key" {
+			value = redactedAPIKey
+		}
+		fmt.Fprintf(buf, "  %s: %s\n", key, value)
+	}
+	writeDumpBody(buf, reqBody)
+
+	if err != nil {
+		fmt.Fprintf(buf, "<-- error (%s): %v\n\n", elapsed, err)
+	} else {
+		fmt.Fprintf(buf, "<-- %d %s (%s)\n", statusCode, http.StatusText(statusCode), elapsed)
+		writeDumpBody(buf, respBody)
+		buf.WriteString("\n")
+	}
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	_, _ = d.writer.Write(buf.Bytes())
+}
+
+func writeDumpBody(buf *bytes.Buffer, body []byte) {
+	if len(body) == 0 {
+		return
+	}
+	buf.WriteString("Body:\n")
+	pretty := new(bytes.Buffer)
+	if err := json.Indent(pretty, body, "", "  "); err != nil {
+		buf.Write(body)
+	} else {
+		buf.Write(pretty.Bytes())
+	}
+	buf.WriteString("\n")
+}
+
+// flush flush debug writer when it's buffered, eg: bufio.Writer.
+func (d *debugger) flush() error {
+	if d == nil || d.writer == nil {
+		return nil
+	}
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if w, ok := d.writer.(interface{ Flush() error }); ok {
+		return w.Flush()
+	}
+	return nil
+}
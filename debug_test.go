@@ -0,0 +1,74 @@
+package glockify
+
+import (
+	"bytes"
+	"fmt"
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/suite"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+type DebugTestSuite struct {
+	suite.Suite
+	server *httptest.Server
+}
+
+func (s *DebugTestSuite) SetupTest() {
+	testMux := mux.NewRouter()
+
+	testMux.HandleFunc("/workspaces", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		_, err := fmt.Fprintf(w, `[{"id":"dummy"}]`)
+		s.Require().Nil(err)
+	})
+	testMux.HandleFunc("/workspaces/{workspaceID}/clients", func(w http.ResponseWriter,
+		r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		_, err := fmt.Fprintf(w, `{"id":"dummy"}`)
+		s.Require().Nil(err)
+	})
+
+	s.server = newJSONServer(testMux)
+}
+
+func (s *DebugTestSuite) TearDownTest() {
+	s.server.Close()
+}
+
+func (s *DebugTestSuite) TestDump() {
+	buf := new(bytes.Buffer)
+	glock := New("secret-key", WithDebug(buf), WithEndpoint(Endpoint{
+		Base: s.server.URL,
+	}))
+
+	_, err := glock.Client.Add("Workspace1", "Client 1")
+	s.Require().Nil(err)
+
+	dump := buf.String()
+	s.Require().Contains(dump, "--> POST "+s.server.URL+"/workspaces/Workspace1/clients")
+	s.Require().Contains(dump, "X-Api-Key: "+redactedAPIKey)
+	s.Require().NotContains(dump, "secret-key")
+	s.Require().Contains(dump, "{\n  \"name\": \"Client 1\"\n}")
+	s.Require().Contains(dump, "<-- 200 OK")
+}
+
+func (s *DebugTestSuite) TestFilterResource() {
+	buf := new(bytes.Buffer)
+	glock := New(dummyAPIKey, WithDebug(buf, ResourceClient), WithEndpoint(Endpoint{
+		Base: s.server.URL,
+	}))
+
+	_, err := glock.Workspace.All()
+	s.Require().Nil(err)
+	s.Require().Empty(buf.String())
+
+	_, err = glock.Client.Add("Workspace1", "Client 1")
+	s.Require().Nil(err)
+	s.Require().NotEmpty(buf.String())
+}
+
+func TestDebug(t *testing.T) {
+	suite.Run(t, &DebugTestSuite{})
+}
@@ -0,0 +1,184 @@
+package glockify
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// Change describe one field which value differs between two models.
+type Change struct {
+	Field string
+	From  interface{}
+	To    interface{}
+}
+
+// String format change, eg: `name: "Old" -> "New"`.
+func (c Change) String() string {
+	return fmt.Sprintf("%s: %s -> %s", c.Field, formatChangeValue(c.From),
+		formatChangeValue(c.To))
+}
+
+func formatChangeValue(v interface{}) string {
+	if v != nil && reflect.TypeOf(v).Kind() == reflect.String {
+		return fmt.Sprintf("%q", reflect.ValueOf(v).String())
+	}
+	if s, ok := v.(fmt.Stringer); ok {
+		return s.String()
+	}
+	return fmt.Sprintf("%v", v)
+}
+
+// Diff is result of comparing two models: options needed to transform one into
+// the other, grouped by the call they're given to, along with the changes.
+type Diff struct {
+	// Update is given to Update of the model's node.
+	Update []RequestOption
+	// Estimate is given to ProjectNode.UpdateEstimate.
+	Estimate []RequestOption
+	// Memberships is given to ProjectNode.UpdateMemberships.
+	Memberships []RequestOption
+	// Changes list every differing field.
+	Changes []Change
+}
+
+// Empty report whether there's no change.
+func (d Diff) Empty() bool {
+	return len(d.Changes) == 0
+}
+
+// Summary return human-readable changes, one per line.
+func (d Diff) Summary() string {
+	if d.Empty() {
+		return "no changes"
+	}
+	lines := make([]string, len(d.Changes))
+	for i, change := range d.Changes {
+		lines[i] = change.String()
+	}
+	return strings.Join(lines, "\n")
+}
+
+func (d *Diff) add(field string, from, to interface{}) {
+	d.Changes = append(d.Changes, Change{Field: field, From: from, To: to})
+}
+
+// DiffClient compare from and to, and return options for ClientNode.Update
+// which transform from into to.
+func DiffClient(from, to Client) Diff {
+	d := Diff{}
+	if from.Name != to.Name {
+		d.add("name", from.Name, to.Name)
+		d.Update = append(d.Update, WithName(to.Name))
+	}
+	if from.Archived != to.Archived {
+		d.add("archived", from.Archived, to.Archived)
+		d.Update = append(d.Update, WithArchived(to.Archived))
+	}
+	return d
+}
+
+// DiffProject compare from and to, and return options for ProjectNode.Update,
+// ProjectNode.UpdateEstimate and ProjectNode.UpdateMemberships which transform
+// from into to. Memberships are compared regardless of their order, and sent
+// whole when they differ.
+func DiffProject(from, to Project) Diff {
+	d := Diff{}
+	if from.Name != to.Name {
+		d.add("name", from.Name, to.Name)
+		d.Update = append(d.Update, WithName(to.Name))
+	}
+	if from.ClientID != to.ClientID {
+		d.add("clientId", from.ClientID, to.ClientID)
+		d.Update = append(d.Update, WithClientID(to.ClientID))
+	}
+	if from.Public != to.Public {
+		d.add("public", from.Public, to.Public)
+		d.Update = append(d.Update, WithIsPublic(to.Public))
+	}
+	if from.HourlyRate != to.HourlyRate {
+		d.add("hourlyRate", from.HourlyRate, to.HourlyRate)
+		d.Update = append(d.Update, WithHourlyRate(to.HourlyRate))
+	}
+	if from.Color != to.Color {
+		d.add("color", from.Color, to.Color)
+		d.Update = append(d.Update, WithColor(to.Color))
+	}
+	if from.Note != to.Note {
+		d.add("note", from.Note, to.Note)
+		d.Update = append(d.Update, WithNote(to.Note))
+	}
+	if from.Billable != to.Billable {
+		d.add("billable", from.Billable, to.Billable)
+		d.Update = append(d.Update, WithBillable(to.Billable))
+	}
+	if from.Archived != to.Archived {
+		d.add("archived", from.Archived, to.Archived)
+		d.Update = append(d.Update, WithArchived(to.Archived))
+	}
+	if from.TimeEstimate != to.TimeEstimate {
+		d.add("timeEstimate", from.TimeEstimate, to.TimeEstimate)
+		d.Estimate = append(d.Estimate, WithTimeEstimate(to.TimeEstimate))
+	}
+	if from.BudgetEstimate != to.BudgetEstimate {
+		d.add("budgetEstimate", from.BudgetEstimate, to.BudgetEstimate)
+		d.Estimate = append(d.Estimate, WithBudgetEstimate(to.BudgetEstimate))
+	}
+	if !sameElements(from.Memberships, to.Memberships) {
+		d.add("memberships", from.Memberships, to.Memberships)
+		d.Memberships = append(d.Memberships, WithMemberships(to.Memberships...))
+	}
+	return d
+}
+
+// DiffTask compare from and to, and return options for TaskNode.Update which
+// transform from into to. Assignees are compared regardless of their order.
+// Status unknown to this package is left unchanged, like Task.UpdateOptions.
+func DiffTask(from, to Task) Diff {
+	d := Diff{}
+	if from.Name != to.Name {
+		d.add("name", from.Name, to.Name)
+		d.Update = append(d.Update, WithName(to.Name))
+	}
+	if !sameElements(from.AssigneeIds, to.AssigneeIds) {
+		d.add("assigneeIds", from.AssigneeIds, to.AssigneeIds)
+		d.Update = append(d.Update, WithAssigneeIDs(to.AssigneeIds))
+	}
+	if from.Estimate != to.Estimate {
+		d.add("estimate", from.Estimate, to.Estimate)
+		d.Update = append(d.Update, WithEstimate(to.Estimate))
+	}
+	if from.Billable != to.Billable {
+		d.add("billable", from.Billable, to.Billable)
+		d.Update = append(d.Update, WithBillable(to.Billable))
+	}
+	if from.Status != to.Status && to.Status != "" && to.Status != TaskStatusUnknown {
+		d.add("status", from.Status, to.Status)
+		d.Update = append(d.Update, WithStatus(to.Status))
+	}
+	return d
+}
+
+// sameElements report whether slices a and b hold the same elements,
+// regardless of their order.
+func sameElements(a, b interface{}) bool {
+	va, vb := reflect.ValueOf(a), reflect.ValueOf(b)
+	if va.Len() != vb.Len() {
+		return false
+	}
+	used := make([]bool, vb.Len())
+	for i := 0; i < va.Len(); i++ {
+		found := false
+		for j := 0; j < vb.Len(); j++ {
+			if !used[j] && reflect.DeepEqual(va.Index(i).Interface(), vb.Index(j).Interface()) {
+				used[j] = true
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,98 @@
+package glockify
+
+import (
+	"encoding/json"
+	"github.com/stretchr/testify/suite"
+	"testing"
+	"time"
+)
+
+type DiffTestSuite struct {
+	suite.Suite
+}
+
+func (s *DiffTestSuite) TestClient() {
+	d := DiffClient(Client{Name: "Old"}, Client{Name: "New", Archived: true})
+	s.Require().Len(d.Update, 2)
+	s.Require().Equal("name: \"Old\" -> \"New\"\narchived: false -> true", d.Summary())
+
+	fields := clientUpdateRequest("", d.Update).fields
+	s.Require().Equal(clientUpdateFields{Archived: boolPtr(true), Name: stringPtr("New")},
+		fields)
+
+	s.Require().True(DiffClient(Client{Name: "Same"}, Client{Name: "Same"}).Empty())
+	s.Require().Equal("no changes", DiffClient(Client{}, Client{}).Summary())
+}
+
+func (s *DiffTestSuite) TestProject() {
+	from := Project{
+		Name:        "Project",
+		ClientID:    "Client1",
+		Color:       "#ffffff",
+		HourlyRate:  NewMoney(1000, "USD"),
+		Memberships: []Memberships{{UserID: "User1"}, {UserID: "User2"}},
+	}
+	to := from
+	to.ClientID = ""
+	to.Note = "Note"
+	to.HourlyRate = NewMoney(2000, "USD")
+	to.TimeEstimate = TimeEstimate{Estimate: Duration(time.Hour), Type: EstimateTypeManual}
+	to.Memberships = []Memberships{{UserID: "User2"}, {UserID: "User1"}}
+
+	d := DiffProject(from, to)
+	s.Require().Len(d.Update, 3)
+	s.Require().Len(d.Estimate, 1)
+	s.Require().Empty(d.Memberships)
+	s.Require().Equal(`clientId: "Client1" -> ""`, d.Changes[0].String())
+	s.Require().Equal("hourlyRate: 10.00 USD -> 20.00 USD", d.Changes[1].String())
+
+	req := projectUpdateRequest("", d.Update)
+	s.Require().Equal(projectUpdateFields{
+		ClientID:   stringPtr(""),
+		HourlyRate: &to.HourlyRate,
+		Note:       stringPtr("Note"),
+	}, req.fields)
+
+	to.Memberships = append(to.Memberships, Memberships{UserID: "User3"})
+	d = DiffProject(from, to)
+	s.Require().Len(d.Memberships, 1)
+	fields := projectUpdateMembershipRequest("", d.Memberships).fields
+	s.Require().Equal(projectUpdateMembershipsFields{Memberships: &to.Memberships}, fields)
+
+	to.Memberships = nil
+	d = DiffProject(from, to)
+	s.Require().Len(d.Memberships, 1)
+	body, err := json.Marshal(projectUpdateMembershipRequest("", d.Memberships).fields)
+	s.Require().Nil(err)
+	s.Require().JSONEq(`{"memberships":[]}`, string(body))
+
+	body, err = json.Marshal(projectUpdateMembershipRequest("", nil).fields)
+	s.Require().Nil(err)
+	s.Require().JSONEq(`{}`, string(body))
+}
+
+func (s *DiffTestSuite) TestTask() {
+	from := Task{Name: "Task", AssigneeIds: []UserID{"User1", "User2"}, Status: TaskStatusActive}
+	to := Task{Name: "Task", AssigneeIds: []UserID{"User2", "User1"}, Status: TaskStatusDone}
+
+	d := DiffTask(from, to)
+	s.Require().Len(d.Update, 1)
+	s.Require().Equal(`status: "ACTIVE" -> "DONE"`, d.Summary())
+
+	for _, status := range []TaskStatus{"", TaskStatusUnknown} {
+		to.Status = status
+		s.Require().True(DiffTask(from, to).Empty())
+	}
+}
+
+func boolPtr(v bool) *bool {
+	return &v
+}
+
+func stringPtr(v string) *string {
+	return &v
+}
+
+func TestDiff(t *testing.T) {
+	suite.Run(t, &DiffTestSuite{})
+}
@@ -0,0 +1,210 @@
+package glockify
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Duration is time.Duration encoded in Clockify's ISO-8601 duration format,
+// eg: "PT2H" for 2 hour. Convert from time.Duration with Duration(d).
+type Duration time.Duration
+
+const day = 24 * time.Hour
+
+// ParseDuration parse ISO-8601 duration such as "PT1H30M", "P1DT2H" or
+// "PT0.5S". Weeks, days, hours, minutes and seconds are supported, any of them
+// may be fractional, and each of them may appear once in that order. Years and
+// months are rejected since their length is ambiguous.
+func ParseDuration(s string) (Duration, error) {
+	orig := s
+	neg := false
+	if strings.HasPrefix(s, "-") {
+		neg = true
+		s = s[1:]
+	}
+	if !strings.HasPrefix(s, "P") || len(s) < 3 {
+		return 0, fmt.Errorf("invalid duration %q", orig)
+	}
+	s = s[1:]
+
+	var total int64
+	inTime := false
+	lastRank := -1
+	for s != "" {
+		if s[0] == 'T' {
+			if inTime || len(s) == 1 {
+				return 0, fmt.Errorf("invalid duration %q", orig)
+			}
+			inTime = true
+			s = s[1:]
+			continue
+		}
+
+		i := 0
+		for i < len(s) && (s[i] >= '0' && s[i] <= '9' || s[i] == '.' || s[i] == ',') {
+			i++
+		}
+		if i == 0 || i == len(s) {
+			return 0, fmt.Errorf("invalid duration %q", orig)
+		}
+		number, designator := strings.Replace(s[:i], ",", ".", 1), s[i]
+		s = s[i+1:]
+
+		var unit time.Duration
+		var rank int
+		switch {
+		case !inTime && designator == 'W':
+			unit, rank = 7*day, 0
+		case !inTime && designator == 'D':
+			unit, rank = day, 1
+		case inTime && designator == 'H':
+			unit, rank = time.Hour, 2
+		case inTime && designator == 'M':
+			unit, rank = time.Minute, 3
+		case inTime && designator == 'S':
+			unit, rank = time.Second, 4
+		default:
+			return 0, fmt.Errorf("invalid duration %q: unsupported designator %q", orig,
+				designator)
+		}
+		if rank <= lastRank {
+			return 0, fmt.Errorf("invalid duration %q: designator %q repeated or out of order",
+				orig, designator)
+		}
+		lastRank = rank
+
+		value, err := durationComponent(number, unit)
+		if err != nil {
+			return 0, fmt.Errorf("invalid duration %q: %w", orig, err)
+		}
+		if total > math.MaxInt64-value {
+			return 0, fmt.Errorf("invalid duration %q: overflow", orig)
+		}
+		total += value
+	}
+
+	if neg {
+		total = -total
+	}
+	return Duration(total), nil
+}
+
+func durationComponent(number string, unit time.Duration) (int64, error) {
+	whole, frac := number, ""
+	if i := strings.IndexByte(number, '.'); i >= 0 {
+		whole, frac = number[:i], number[i+1:]
+	}
+	if whole == "" && frac == "" {
+		return 0, fmt.Errorf("empty number")
+	}
+
+	var value int64
+	if whole != "" {
+		w, err := strconv.ParseInt(whole, 10, 64)
+		if err != nil {
+			return 0, err
+		}
+		if w > math.MaxInt64/int64(unit) {
+			return 0, fmt.Errorf("overflow")
+		}
+		value = w * int64(unit)
+	}
+	if frac != "" {
+		f, err := strconv.ParseFloat("0."+frac, 64)
+		if err != nil {
+			return 0, err
+		}
+		fraction := int64(math.Round(f * float64(unit)))
+		if value > math.MaxInt64-fraction {
+			return 0, fmt.Errorf("overflow")
+		}
+		value += fraction
+	}
+	return value, nil
+}
+
+// TimeDuration return d as time.Duration.
+func (d Duration) TimeDuration() time.Duration {
+	return time.Duration(d)
+}
+
+// String format d the same way Clockify does, using hours, minutes and
+// seconds only, eg: "PT26H30M" or "PT0.5S".
+func (d Duration) String() string {
+	if d == 0 {
+		return "PT0S"
+	}
+
+	buf := new(strings.Builder)
+	n := time.Duration(d)
+	if n < 0 {
+		buf.WriteString("-")
+		n = -n
+	}
+	buf.WriteString("PT")
+
+	hours := n / time.Hour
+	n -= hours * time.Hour
+	minutes := n / time.Minute
+	n -= minutes * time.Minute
+	seconds := n / time.Second
+	nanos := n - seconds*time.Second
+
+	if hours > 0 {
+		buf.WriteString(strconv.FormatInt(int64(hours), 10) + "H")
+	}
+	if minutes > 0 {
+		buf.WriteString(strconv.FormatInt(int64(minutes), 10) + "M")
+	}
+	if seconds > 0 || nanos > 0 {
+		buf.WriteString(strconv.FormatInt(int64(seconds), 10))
+		if nanos > 0 {
+			buf.WriteString("." + strings.TrimRight(fmt.Sprintf("%09d", nanos), "0"))
+		}
+		buf.WriteString("S")
+	}
+	return buf.String()
+}
+
+// MarshalJSON encode d as ISO-8601 duration string.
+func (d Duration) MarshalJSON() ([]byte, error) {
+	return json.Marshal(d.String())
+}
+
+// UnmarshalJSON decode ISO-8601 duration string. Null and empty string decode
+// to zero, while number is treated as seconds.
+func (d *Duration) UnmarshalJSON(data []byte) error {
+	data = bytes.TrimSpace(data)
+	if bytes.Equal(data, []byte("null")) {
+		*d = 0
+		return nil
+	}
+	if len(data) > 0 && data[0] != '"' {
+		seconds, err := strconv.ParseFloat(string(data), 64)
+		if err != nil {
+			return fmt.Errorf("invalid duration %s", data)
+		}
+		*d = Duration(math.Round(seconds * float64(time.Second)))
+		return nil
+	}
+
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+	if s == "" {
+		*d = 0
+		return nil
+	}
+	parsed, err := ParseDuration(s)
+	if err != nil {
+		return err
+	}
+	*d = parsed
+	return nil
+}
@@ -0,0 +1,94 @@
+package glockify
+
+import (
+	"encoding/json"
+	"github.com/stretchr/testify/suite"
+	"testing"
+	"time"
+)
+
+type DurationTestSuite struct {
+	suite.Suite
+}
+
+var testsDurationParse = []struct {
+	name    string
+	value   string
+	want    time.Duration
+	wantErr bool
+}{
+	{name: "Hours", value: "PT2H", want: 2 * time.Hour},
+	{name: "Combined", value: "PT1H30M15S", want: time.Hour + 30*time.Minute + 15*time.Second},
+	{name: "Days", value: "P1DT2H", want: 26 * time.Hour},
+	{name: "Weeks", value: "P1W", want: 7 * 24 * time.Hour},
+	{name: "Fractional Seconds", value: "PT0.25S", want: 250 * time.Millisecond},
+	{name: "Fractional Hours", value: "PT1,5H", want: 90 * time.Minute},
+	{name: "Zero", value: "PT0S", want: 0},
+	{name: "Negative", value: "-PT1M", want: -time.Minute},
+	{name: "Months", value: "P1M", wantErr: true},
+	{name: "Missing Time", value: "P1DT", wantErr: true},
+	{name: "Missing Designator", value: "PT10", wantErr: true},
+	{name: "Not Duration", value: "2h", wantErr: true},
+	{name: "Out Of Order", value: "PT1M1H", wantErr: true},
+	{name: "Repeated Designator", value: "PT1H1H", wantErr: true},
+	{name: "Weeks After Days", value: "P1D1W", wantErr: true},
+	{name: "Overflow", value: "PT2562048H", wantErr: true},
+	{name: "Fractional Overflow", value: "PT9223372036.9S", wantErr: true},
+}
+
+func (s *DurationTestSuite) TestParse() {
+	for _, tc := range testsDurationParse {
+		s.Run(tc.name, func() {
+			d, err := ParseDuration(tc.value)
+			if tc.wantErr {
+				s.Require().NotNil(err)
+				return
+			}
+			s.Require().Nil(err)
+			s.Require().Equal(tc.want, d.TimeDuration())
+		})
+	}
+}
+
+var testsDurationString = []struct {
+	name  string
+	value time.Duration
+	want  string
+}{
+	{name: "Zero", value: 0, want: "PT0S"},
+	{name: "Hours", value: 26 * time.Hour, want: "PT26H"},
+	{name: "Combined", value: time.Hour + 30*time.Minute + 15*time.Second, want: "PT1H30M15S"},
+	{name: "Fractional Seconds", value: 1500 * time.Millisecond, want: "PT1.5S"},
+}
+
+func (s *DurationTestSuite) TestString() {
+	for _, tc := range testsDurationString {
+		s.Run(tc.name, func() {
+			s.Require().Equal(tc.want, Duration(tc.value).String())
+		})
+	}
+}
+
+func (s *DurationTestSuite) TestJSON() {
+	task := Task{}
+	err := json.Unmarshal([]byte(`{"estimate":"PT2H30M"}`), &task)
+	s.Require().Nil(err)
+	s.Require().Equal(150*time.Minute, task.Estimate.TimeDuration())
+
+	b, err := json.Marshal(task)
+	s.Require().Nil(err)
+	s.Require().Contains(string(b), `"estimate":"PT2H30M"`)
+
+	b, err = json.Marshal(Task{})
+	s.Require().Nil(err)
+	s.Require().NotContains(string(b), "estimate")
+
+	tasks := Tasks{}
+	err = json.Unmarshal([]byte(`{"duration":null,"estimate":""}`), &tasks)
+	s.Require().Nil(err)
+	s.Require().Zero(tasks.Duration)
+}
+
+func TestDuration(t *testing.T) {
+	suite.Run(t, &DurationTestSuite{})
+}
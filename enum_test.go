@@ -0,0 +1,62 @@
+package glockify
+
+import (
+	"encoding/json"
+	"github.com/stretchr/testify/suite"
+	"testing"
+)
+
+type EnumTestSuite struct {
+	suite.Suite
+}
+
+func (s *EnumTestSuite) TestKnown() {
+	workspace := Workspace{}
+	err := json.Unmarshal([]byte(`{
+		"memberships": [{"membershipStatus": "ACTIVE", "membershipType": "WORKSPACE"}],
+		"workspaceSettings": {
+			"automaticLock": {"type": "WEEKLY", "changeDay": "FRIDAY"},
+			"round": {"round": "Round to nearest", "minutes": "15"}
+		}
+	}`), &workspace)
+	s.Require().Nil(err)
+	s.Require().Equal(MembershipStatusActive, workspace.Memberships[0].MembershipStatus)
+	s.Require().Equal(MembershipTypeWorkspace, workspace.Memberships[0].MembershipType)
+	s.Require().Equal(AutomaticLockTypeWeekly, workspace.WorkspaceSettings.AutomaticLock.Type)
+	s.Require().Equal(DayOfWeekFriday, workspace.WorkspaceSettings.AutomaticLock.ChangeDay)
+	s.Require().Equal(RoundTypeNearest, workspace.WorkspaceSettings.Round.Round)
+}
+
+func (s *EnumTestSuite) TestUnknown() {
+	task := Task{}
+	s.Require().Nil(json.Unmarshal([]byte(`{"status": "ARCHIVED"}`), &task))
+	s.Require().Equal(TaskStatusUnknown, task.Status)
+
+	task = Task{}
+	s.Require().Nil(json.Unmarshal([]byte(`{"status": null}`), &task))
+	s.Require().Equal(TaskStatus(""), task.Status)
+
+	s.Require().NotNil(json.Unmarshal([]byte(`{"status": 1}`), &task))
+}
+
+func (s *EnumTestSuite) TestUnknownRoundTrip() {
+	raw := `{"id":"Task1","projectId":"Project1","status":"ARCHIVED"}`
+	glock := New(dummyAPIKey, WithPreserveUnknownFields(true))
+	task := Task{}
+	s.Require().Nil(glock.requester.unmarshal([]byte(raw), &task))
+	s.Require().Equal(TaskStatusUnknown, task.Status)
+
+	data, err := json.Marshal(task)
+	s.Require().Nil(err)
+	s.Require().Contains(string(data), `"status":"ARCHIVED"`)
+	s.Require().NotContains(string(data), enumUnknown)
+
+	workspace := Workspace{}
+	s.Require().Nil(glock.requester.unmarshal([]byte(`{"id":"Workspace1",
+		"memberships":[{"membershipStatus":"SUSPENDED"}]}`), &workspace))
+	s.Require().Equal(MembershipStatusUnknown, workspace.Memberships[0].MembershipStatus)
+}
+
+func TestEnum(t *testing.T) {
+	suite.Run(t, &EnumTestSuite{})
+}
@@ -0,0 +1,236 @@
+package glockify
+
+import (
+	"encoding/json"
+	"log"
+	"net/url"
+	"reflect"
+	"strings"
+)
+
+const extraFieldsKey = "extra-fields"
+
+// WithPreserveUnknownFields if set to true, JSON properties of models such as
+// Workspace, Client, Project and Task unknown to this package are kept in their
+// Extra field, and emitted again when those models are marshaled. Enum value
+// decoded into Unknown, eg: TaskStatusUnknown, is kept there too, so the value
+// sent by Clockify is emitted instead of Unknown. It has no effect when
+// WithDisallowUnknownFields is set. Default to false.
+func WithPreserveUnknownFields(preserve bool) Option {
+	return func(g *Glockify) {
+		g.response.preserveUnknownFields = preserve
+	}
+}
+
+// WithExtraFields when applied to Update request, send extra JSON properties
+// along with fields set by other options, eg: Extra of fetched Project.
+// Properties set by other options take precedence.
+func WithExtraFields(extra map[string]json.RawMessage) RequestOption {
+	return RequestOption{
+		paramsProvider: func(v url.Values) string {
+			e, err := json.Marshal(extra)
+			if err != nil {
+				log.Fatalf("%v", err)
+			}
+			v.Set(extraFieldsKey, string(e))
+			return extraFieldsKey
+		},
+	}
+}
+
+// extraHolder is implemented by models retaining unknown JSON properties.
+type extraHolder interface {
+	setExtra(extra map[string]json.RawMessage)
+}
+
+func (w *Workspace) setExtra(extra map[string]json.RawMessage)   { w.Extra = extra }
+func (c *Client) setExtra(extra map[string]json.RawMessage)      { c.Extra = extra }
+func (p *Project) setExtra(extra map[string]json.RawMessage)     { p.Extra = extra }
+func (t *Task) setExtra(extra map[string]json.RawMessage)        { t.Extra = extra }
+func (t *TimeEntry) setExtra(extra map[string]json.RawMessage)   { t.Extra = extra }
+func (t *Tag) setExtra(extra map[string]json.RawMessage)         { t.Extra = extra }
+func (u *User) setExtra(extra map[string]json.RawMessage)        { u.Extra = extra }
+func (u *UserGroup) setExtra(extra map[string]json.RawMessage)   { u.Extra = extra }
+func (c *CustomField) setExtra(extra map[string]json.RawMessage) { c.Extra = extra }
+func (w *Webhook) setExtra(extra map[string]json.RawMessage)     { w.Extra = extra }
+
+// MarshalJSON encode Workspace along with its Extra properties.
+func (w Workspace) MarshalJSON() ([]byte, error) {
+	type workspace Workspace
+	return marshalWithExtra(workspace(w), w.Extra)
+}
+
+// MarshalJSON encode Client along with its Extra properties.
+func (c Client) MarshalJSON() ([]byte, error) {
+	type client Client
+	return marshalWithExtra(client(c), c.Extra)
+}
+
+// MarshalJSON encode Project along with its Extra properties.
+func (p Project) MarshalJSON() ([]byte, error) {
+	type project Project
+	return marshalWithExtra(project(p), p.Extra)
+}
+
+// MarshalJSON encode Task along with its Extra properties.
+func (t Task) MarshalJSON() ([]byte, error) {
+	type task Task
+	return marshalWithExtra(task(t), t.Extra)
+}
+
+// MarshalJSON encode TimeEntry along with its Extra properties.
+func (t TimeEntry) MarshalJSON() ([]byte, error) {
+	type timeEntry TimeEntry
+	return marshalWithExtra(timeEntry(t), t.Extra)
+}
+
+// MarshalJSON encode Tag along with its Extra properties.
+func (t Tag) MarshalJSON() ([]byte, error) {
+	type tag Tag
+	return marshalWithExtra(tag(t), t.Extra)
+}
+
+// MarshalJSON encode User along with its Extra properties.
+func (u User) MarshalJSON() ([]byte, error) {
+	type user User
+	return marshalWithExtra(user(u), u.Extra)
+}
+
+// MarshalJSON encode UserGroup along with its Extra properties.
+func (u UserGroup) MarshalJSON() ([]byte, error) {
+	type userGroup UserGroup
+	return marshalWithExtra(userGroup(u), u.Extra)
+}
+
+// MarshalJSON encode CustomField along with its Extra properties.
+func (c CustomField) MarshalJSON() ([]byte, error) {
+	type customField CustomField
+	return marshalWithExtra(customField(c), c.Extra)
+}
+
+// MarshalJSON encode Webhook along with its Extra properties.
+func (w Webhook) MarshalJSON() ([]byte, error) {
+	type webhook Webhook
+	return marshalWithExtra(webhook(w), w.Extra)
+}
+
+// fieldsWithExtra marshal request fields along with extra properties.
+type fieldsWithExtra struct {
+	fields interface{}
+	extra  map[string]json.RawMessage
+}
+
+func (f fieldsWithExtra) MarshalJSON() ([]byte, error) {
+	return marshalWithExtra(f.fields, f.extra)
+}
+
+// withExtraFields wrap fields with extra properties given by WithExtraFields.
+func withExtraFields(fields interface{}, params url.Values) interface{} {
+	if params.Get(extraFieldsKey) == "" {
+		return fields
+	}
+	extra := make(map[string]json.RawMessage)
+	_ = json.Unmarshal([]byte(params.Get(extraFieldsKey)), &extra)
+	return fieldsWithExtra{fields: fields, extra: extra}
+}
+
+func marshalWithExtra(v interface{}, extra map[string]json.RawMessage) ([]byte, error) {
+	data, err := json.Marshal(v)
+	if err != nil || len(extra) == 0 {
+		return data, err
+	}
+	properties := make(map[string]json.RawMessage)
+	if err := json.Unmarshal(data, &properties); err != nil {
+		return nil, err
+	}
+	for key, value := range extra {
+		// Enum decoded into Unknown is replaced by its raw value kept in extra.
+		if current, ok := properties[key]; !ok || string(current) == `"`+enumUnknown+`"` {
+			properties[key] = value
+		}
+	}
+	return json.Marshal(properties)
+}
+
+// captureExtra walk v decoded from data, and store properties unknown to
+// every extraHolder found, including the ones nested in slices and fields.
+func captureExtra(data []byte, v reflect.Value) {
+	for v.Kind() == reflect.Ptr || v.Kind() == reflect.Interface {
+		if v.IsNil() {
+			return
+		}
+		v = v.Elem()
+	}
+
+	switch v.Kind() {
+	case reflect.Slice, reflect.Array:
+		var items []json.RawMessage
+		if err := json.Unmarshal(data, &items); err != nil {
+			return
+		}
+		for i := 0; i < v.Len() && i < len(items); i++ {
+			captureExtra(items[i], v.Index(i))
+		}
+	case reflect.Struct:
+		properties := make(map[string]json.RawMessage)
+		if err := json.Unmarshal(data, &properties); err != nil {
+			return
+		}
+		fields := jsonFields(v.Type())
+		unknown := make(map[string]json.RawMessage)
+		for key, raw := range properties {
+			index, ok := fields[strings.ToLower(key)]
+			if !ok {
+				unknown[key] = raw
+				continue
+			}
+			field := v.FieldByIndex(index)
+			captureExtra(raw, field)
+			if unknownEnum(field) {
+				unknown[key] = raw
+			}
+		}
+		if len(unknown) > 0 && v.CanAddr() {
+			if holder, ok := v.Addr().Interface().(extraHolder); ok {
+				holder.setExtra(unknown)
+			}
+		}
+	}
+}
+
+// unknownEnum report whether v is enum decoded into its Unknown value.
+func unknownEnum(v reflect.Value) bool {
+	if v.Kind() != reflect.String || v.String() != enumUnknown || !v.CanAddr() {
+		return false
+	}
+	_, ok := v.Addr().Interface().(json.Unmarshaler)
+	return ok
+}
+
+// jsonFields map lower-cased JSON property name of t to its field index,
+// matching case-insensitive behaviour of encoding/json.
+func jsonFields(t reflect.Type) map[string][]int {
+	fields := make(map[string][]int)
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		tag := f.Tag.Get("json")
+		if tag == "-" {
+			continue
+		}
+		name := strings.Split(tag, ",")[0]
+		if f.Anonymous && name == "" && f.Type.Kind() == reflect.Struct {
+			for embedded, index := range jsonFields(f.Type) {
+				fields[embedded] = append([]int{i}, index...)
+			}
+			continue
+		}
+		if f.PkgPath != "" {
+			continue
+		}
+		if name == "" {
+			name = f.Name
+		}
+		fields[strings.ToLower(name)] = []int{i}
+	}
+	return fields
+}
@@ -0,0 +1,174 @@
+package glockify
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/suite"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+type ExtraTestSuite struct {
+	suite.Suite
+	server      *httptest.Server
+	gotBody     map[string]json.RawMessage
+	projectJSON string
+}
+
+func (s *ExtraTestSuite) SetupTest() {
+	s.projectJSON = `{"id":"1","name":"Project 1","newField":{"a":1},` +
+		`"tasks":[{"id":"Task1","taskField":"x"}]}`
+	testMux := mux.NewRouter()
+
+	testMux.HandleFunc("/workspaces/{workspaceID}/projects/{projectID}",
+		func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusOK)
+			_, err := fmt.Fprint(w, s.projectJSON)
+			s.Require().Nil(err)
+		}).Methods("GET")
+	testMux.HandleFunc("/workspaces/{workspaceID}/projects/{projectID}",
+		func(w http.ResponseWriter, r *http.Request) {
+			body, err := ioutil.ReadAll(r.Body)
+			s.Require().Nil(err)
+			s.gotBody = make(map[string]json.RawMessage)
+			s.Require().Nil(json.Unmarshal(body, &s.gotBody))
+			w.WriteHeader(http.StatusOK)
+			_, err = fmt.Fprint(w, s.projectJSON)
+			s.Require().Nil(err)
+		}).Methods("PUT")
+
+	s.server = newJSONServer(testMux)
+}
+
+func (s *ExtraTestSuite) TearDownTest() {
+	s.server.Close()
+}
+
+func (s *ExtraTestSuite) TestDisabled() {
+	glock := New(dummyAPIKey, WithEndpoint(Endpoint{
+		Base: s.server.URL,
+	}))
+
+	project, err := glock.Project.Get("Workspace1", "1")
+	s.Require().Nil(err)
+	s.Require().Nil(project.Extra)
+}
+
+func (s *ExtraTestSuite) TestRoundTrip() {
+	glock := New(dummyAPIKey, WithPreserveUnknownFields(true), WithEndpoint(Endpoint{
+		Base: s.server.URL,
+	}))
+
+	project, err := glock.Project.Get("Workspace1", "1")
+	s.Require().Nil(err)
+	s.Require().Equal(json.RawMessage(`{"a":1}`), project.Extra["newField"])
+	s.Require().Len(project.Extra, 1)
+
+	b, err := json.Marshal(project)
+	s.Require().Nil(err)
+	s.Require().Contains(string(b), `"newField":{"a":1}`)
+	s.Require().Contains(string(b), `"name":"Project 1"`)
+
+	_, err = glock.Project.Update("Workspace1", "1", WithName("Renamed"),
+		WithExtraFields(project.Extra))
+	s.Require().Nil(err)
+	s.Require().Equal(json.RawMessage(`{"a":1}`), s.gotBody["newField"])
+	s.Require().Equal(json.RawMessage(`"Renamed"`), s.gotBody["name"])
+}
+
+var testsExtraTypes = []struct {
+	name  string
+	data  string
+	value func() interface{}
+	extra func(v interface{}) map[string]json.RawMessage
+}{
+	{
+		name:  "Workspace",
+		data:  `{"id":"Workspace1","name":"Work","newField":[1,2]}`,
+		value: func() interface{} { return new(Workspace) },
+		extra: func(v interface{}) map[string]json.RawMessage { return v.(*Workspace).Extra },
+	},
+	{
+		name:  "Client",
+		data:  `{"id":"Client1","name":"Acme","newField":[1,2]}`,
+		value: func() interface{} { return new(Client) },
+		extra: func(v interface{}) map[string]json.RawMessage { return v.(*Client).Extra },
+	},
+	{
+		name:  "Project",
+		data:  `{"id":"Project1","name":"Site","newField":[1,2]}`,
+		value: func() interface{} { return new(Project) },
+		extra: func(v interface{}) map[string]json.RawMessage { return v.(*Project).Extra },
+	},
+	{
+		name:  "Task",
+		data:  `{"id":"Task1","name":"Design","newField":[1,2]}`,
+		value: func() interface{} { return new(Task) },
+		extra: func(v interface{}) map[string]json.RawMessage { return v.(*Task).Extra },
+	},
+	{
+		name:  "TimeEntry",
+		data:  `{"id":"Entry1","description":"Work","newField":[1,2]}`,
+		value: func() interface{} { return new(TimeEntry) },
+		extra: func(v interface{}) map[string]json.RawMessage { return v.(*TimeEntry).Extra },
+	},
+	{
+		name:  "Tag",
+		data:  `{"id":"Tag1","name":"Urgent","newField":[1,2]}`,
+		value: func() interface{} { return new(Tag) },
+		extra: func(v interface{}) map[string]json.RawMessage { return v.(*Tag).Extra },
+	},
+	{
+		name:  "User",
+		data:  `{"id":"User1","name":"Jane","newField":[1,2]}`,
+		value: func() interface{} { return new(User) },
+		extra: func(v interface{}) map[string]json.RawMessage { return v.(*User).Extra },
+	},
+	{
+		name:  "UserGroup",
+		data:  `{"id":"Group1","name":"Design","newField":[1,2]}`,
+		value: func() interface{} { return new(UserGroup) },
+		extra: func(v interface{}) map[string]json.RawMessage { return v.(*UserGroup).Extra },
+	},
+	{
+		name:  "CustomField",
+		data:  `{"id":"Field1","name":"Stage","newField":[1,2]}`,
+		value: func() interface{} { return new(CustomField) },
+		extra: func(v interface{}) map[string]json.RawMessage { return v.(*CustomField).Extra },
+	},
+	{
+		name:  "Webhook",
+		data:  `{"id":"Webhook1","name":"Hook","newField":[1,2]}`,
+		value: func() interface{} { return new(Webhook) },
+		extra: func(v interface{}) map[string]json.RawMessage { return v.(*Webhook).Extra },
+	},
+}
+
+func (s *ExtraTestSuite) TestTypesRoundTrip() {
+	requester := New(dummyAPIKey, WithPreserveUnknownFields(true)).requester
+	for _, tc := range testsExtraTypes {
+		s.Run(tc.name, func() {
+			v := tc.value()
+			s.Require().Nil(requester.unmarshal([]byte(tc.data), v))
+			s.Require().Equal(map[string]json.RawMessage{"newField": json.RawMessage(`[1,2]`)},
+				tc.extra(v))
+
+			b, err := json.Marshal(v)
+			s.Require().Nil(err)
+			got := make(map[string]json.RawMessage)
+			s.Require().Nil(json.Unmarshal(b, &got))
+			want := make(map[string]json.RawMessage)
+			s.Require().Nil(json.Unmarshal([]byte(tc.data), &want))
+			for key, value := range want {
+				s.Require().JSONEq(string(value), string(got[key]), key)
+			}
+		})
+	}
+}
+
+func TestExtra(t *testing.T) {
+	suite.Run(t, &ExtraTestSuite{})
+}
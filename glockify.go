@@ -4,22 +4,39 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
-	"io/ioutil"
+	"io"
 	"log"
 	"net/http"
 	"net/url"
 	"strconv"
+	"strings"
+	"sync"
+	"time"
 )
 
 // Glockify is an entry point to access Clockify API.
 type Glockify struct {
-	Workspace WorkspaceNode
-	Client    ClientNode
-	Project   ProjectNode
-	Task      TaskNode
-
-	apiKey string
+	Workspace   WorkspaceNode
+	Client      ClientNode
+	Project     ProjectNode
+	Task        TaskNode
+	TimeEntry   TimeEntryNode
+	Tag         TagNode
+	User        UserNode
+	UserGroup   UserGroupNode
+	CustomField CustomFieldNode
+	Webhook     WebhookNode
+	Report      ReportNode
+
+	endpoint   Endpoint
+	credential CredentialProvider
+	debug      *debugger
+	response   responseOptions
+	timeouts   map[Operation]time.Duration
+	requester  *requester
+	active     activeWorkspace
 }
 
 // Endpoint specify main endpoints in Clockify.
@@ -34,241 +51,329 @@ type Option func(*Glockify)
 
 const (
 	defaultBaseEndpoint    = "https://api.clockify.me/api/v1"
-	defaultTimeOffEndpoint = "https://reports.api.clockify.me/v1"
-	defaultReportEndpoint  = "https://pto.api.clockify.me/v1"
+	defaultTimeOffEndpoint = "https://pto.api.clockify.me/v1"
+	defaultReportEndpoint  = "https://reports.api.clockify.me/v1"
 )
 
-// New instantiate Glockify with apiKey given.
+// New instantiate Glockify with apiKey given. The apiKey is ignored when
+// WithCredentialProvider is given.
 func New(apiKey string, opts ...Option) *Glockify {
 	g := &Glockify{
-		apiKey: apiKey,
+		endpoint: Endpoint{
+			Base:    defaultBaseEndpoint,
+			TimeOff: defaultTimeOffEndpoint,
+			Report:  defaultReportEndpoint,
+		},
+		credential: NewStaticCredential(apiKey),
+		response: responseOptions{
+			maxSize: defaultMaxResponseSize,
+		},
 	}
-	g.setupNode(Endpoint{
-		Base:    defaultBaseEndpoint,
-		TimeOff: defaultTimeOffEndpoint,
-		Report:  defaultReportEndpoint,
-	})
 
 	for _, opt := range opts {
 		opt(g)
 	}
+	g.setupNode()
 
 	return g
 }
 
-func (g *Glockify) setupNode(endpoint Endpoint) {
+func (g *Glockify) setupNode() {
+	g.requester = &requester{
+		client: &http.Client{
+			Transport: http.DefaultTransport.(*http.Transport).Clone(),
+		},
+		credential: g.credential,
+		debug:      g.debug,
+		response:   g.response,
+		timeouts:   g.timeouts,
+	}
 	g.Workspace = WorkspaceNode{
-		endpoint: endpoint.Base,
-		apiKey:   g.apiKey,
+		endpoint:  g.endpoint.Base,
+		requester: g.requester,
 	}
 	g.Client = ClientNode{
-		endpoint: endpoint.Base,
-		apiKey:   g.apiKey,
+		endpoint:  g.endpoint.Base,
+		requester: g.requester,
 	}
 	g.Project = ProjectNode{
-		endpoint: endpoint.Base,
-		apiKey:   g.apiKey,
+		endpoint:  g.endpoint.Base,
+		requester: g.requester,
 	}
 	g.Task = TaskNode{
-		endpoint: endpoint.Base,
-		apiKey:   g.apiKey,
+		endpoint:  g.endpoint.Base,
+		requester: g.requester,
+	}
+	g.TimeEntry = TimeEntryNode{
+		endpoint:  g.endpoint.Base,
+		requester: g.requester,
+	}
+	g.Tag = TagNode{
+		endpoint:  g.endpoint.Base,
+		requester: g.requester,
+	}
+	g.User = UserNode{
+		endpoint:  g.endpoint.Base,
+		requester: g.requester,
+	}
+	g.UserGroup = UserGroupNode{
+		endpoint:  g.endpoint.Base,
+		requester: g.requester,
+	}
+	g.CustomField = CustomFieldNode{
+		endpoint:  g.endpoint.Base,
+		requester: g.requester,
+	}
+	g.Webhook = WebhookNode{
+		endpoint:  g.endpoint.Base,
+		requester: g.requester,
+	}
+	g.Report = ReportNode{
+		endpoint:  g.endpoint.Report,
+		requester: g.requester,
 	}
 }
 
 // WithEndpoint set endpoint when creating new Glockify.
 func WithEndpoint(endpoint Endpoint) Option {
 	return func(g *Glockify) {
-		defaultEndpoint := Endpoint{
-			Base:    defaultBaseEndpoint,
-			TimeOff: defaultTimeOffEndpoint,
-			Report:  defaultReportEndpoint,
-		}
 		if endpoint.Base != "" {
-			defaultEndpoint.Base = endpoint.Base
+			g.endpoint.Base = endpoint.Base
 		}
 		if endpoint.TimeOff != "" {
-			defaultEndpoint.TimeOff = endpoint.TimeOff
+			g.endpoint.TimeOff = endpoint.TimeOff
 		}
 		if endpoint.Report != "" {
-			defaultEndpoint.Report = endpoint.Report
+			g.endpoint.Report = endpoint.Report
 		}
-		g.setupNode(defaultEndpoint)
 	}
 }
 
-type requestOptions struct {
-	ctx      context.Context
-	apiKey   string
-	endpoint string
-	params   url.Values
-	fields   interface{}
+// WithCredentialProvider set provider consulted for API key on every request,
+// replacing apiKey given to New. Nil provider make every request fail with
+// ErrNoCredential.
+func WithCredentialProvider(provider CredentialProvider) Option {
+	return func(g *Glockify) {
+		g.credential = provider
+	}
 }
 
-func get(opt requestOptions) ([]byte, error) {
-	req, err := http.NewRequestWithContext(opt.ctx, "GET",
-		opt.endpoint, nil)
-	if err != nil {
-		return nil, fmt.Errorf("new request: %w", err)
-	}
-	req.Header.Set("content-type", "application/json")
-	req.Header.Set("X-Api-Key", opt.apiKey)
-	if opt.params != nil {
-		req.URL.RawQuery = opt.params.Encode()
-	}
+// Resource identify kind of Clockify resource a request works on.
+type Resource string
 
-	client := http.Client{}
-	resp, err := client.Do(req)
-	if err != nil {
-		return nil, fmt.Errorf("do: %w", err)
-	}
-	defer func() {
-		if err := resp.Body.Close(); err != nil {
-			log.Printf(fmt.Errorf("close body: %w", err).Error())
-		}
-	}()
+// Possible values of Resource
+const (
+	ResourceWorkspace   Resource = "WORKSPACE"
+	ResourceClient      Resource = "CLIENT"
+	ResourceProject     Resource = "PROJECT"
+	ResourceTask        Resource = "TASK"
+	ResourceUser        Resource = "USER"
+	ResourceTimeEntry   Resource = "TIME_ENTRY"
+	ResourceTag         Resource = "TAG"
+	ResourceUserGroup   Resource = "USER_GROUP"
+	ResourceCustomField Resource = "CUSTOM_FIELD"
+	ResourceWebhook     Resource = "WEBHOOK"
+	ResourceReport      Resource = "REPORT"
+)
 
-	if resp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("http error: status code %d", resp.StatusCode)
-	}
+// Operation classify request for applying default timeout.
+type Operation string
 
-	respBytes, err := ioutil.ReadAll(resp.Body)
-	if err != nil {
-		return nil, fmt.Errorf("read body: %w", err)
+// Possible values of Operation
+const (
+	OperationRead   Operation = "READ"
+	OperationWrite  Operation = "WRITE"
+	OperationList   Operation = "LIST"
+	OperationReport Operation = "REPORT"
+)
+
+// WithDefaultTimeout set timeout applied to every request of operation given
+// when request doesn't use WithTimeout. Zero timeout means no timeout, which
+// is the default for every Operation.
+func WithDefaultTimeout(operation Operation, timeout time.Duration) Option {
+	return func(g *Glockify) {
+		if g.timeouts == nil {
+			g.timeouts = make(map[Operation]time.Duration)
+		}
+		g.timeouts[operation] = timeout
 	}
-	return respBytes, nil
 }
 
-func post(opt requestOptions) ([]byte, error) {
-	buf := new(bytes.Buffer)
-	if opt.fields != nil {
-		bodyJSON, err := json.Marshal(opt.fields)
-		if err != nil {
-			return nil, fmt.Errorf("json marshal: %w", err)
-		}
-		buf = bytes.NewBuffer(bodyJSON)
+type requestOptions struct {
+	ctx       context.Context
+	timeout   time.Duration
+	resource  Resource
+	operation Operation
+	endpoint  string
+	params    url.Values
+	fields    interface{}
+}
+
+// operationOf return operation set by request builder, or infer it from
+// method: GET is OperationRead, the rest is OperationWrite.
+func operationOf(method string, opt requestOptions) Operation {
+	if opt.operation != "" {
+		return opt.operation
 	}
-	req, err := http.NewRequestWithContext(opt.ctx, "POST", opt.endpoint, buf)
-	if err != nil {
-		return nil, fmt.Errorf("new request: %w", err)
+	if method == http.MethodGet {
+		return OperationRead
 	}
-	req.Header.Set("content-type", "application/json")
-	req.Header.Set("X-Api-Key", opt.apiKey)
+	return OperationWrite
+}
 
-	client := http.Client{}
-	resp, err := client.Do(req)
-	if err != nil {
-		return nil, fmt.Errorf("do: %w", err)
+// requester send requests to Clockify on behalf of every node.
+type requester struct {
+	client     *http.Client
+	credential CredentialProvider
+	debug      *debugger
+	response   responseOptions
+	timeouts   map[Operation]time.Duration
+
+	mu       sync.Mutex
+	closed   bool
+	inFlight sync.WaitGroup
+}
+
+// ErrClosed returned when request is made after Glockify.Close called.
+var ErrClosed = errors.New("glockify: closed")
+
+// HTTPError returned when Clockify respond with unexpected status code.
+type HTTPError struct {
+	StatusCode int
+}
+
+func (e *HTTPError) Error() string {
+	return fmt.Sprintf("http error: status code %d", e.StatusCode)
+}
+
+// Close stop accepting new request on every node, wait for in-flight requests
+// to finish or ctx to expire, flush debug writer if it's buffered and close
+// idle connections. It returns ctx error if in-flight requests don't finish
+// in time.
+func (g *Glockify) Close(ctx context.Context) error {
+	return g.requester.close(ctx)
+}
+
+func (r *requester) begin() error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.closed {
+		return ErrClosed
 	}
-	defer func() {
-		if err := resp.Body.Close(); err != nil {
-			log.Printf(fmt.Errorf("close body: %w", err).Error())
-		}
+	r.inFlight.Add(1)
+	return nil
+}
+
+func (r *requester) close(ctx context.Context) error {
+	r.mu.Lock()
+	r.closed = true
+	r.mu.Unlock()
+
+	done := make(chan struct{})
+	go func() {
+		r.inFlight.Wait()
+		close(done)
 	}()
 
-	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusCreated {
-		return nil, fmt.Errorf("http error: status code %d", resp.StatusCode)
+	var err error
+	select {
+	case <-done:
+	case <-ctx.Done():
+		err = fmt.Errorf("wait in-flight requests: %w", ctx.Err())
 	}
 
-	respBytes, err := ioutil.ReadAll(resp.Body)
-	if err != nil {
-		return nil, fmt.Errorf("read body: %w", err)
+	if fErr := r.debug.flush(); fErr != nil && err == nil {
+		err = fmt.Errorf("flush debug: %w", fErr)
 	}
-	return respBytes, nil
+	r.client.CloseIdleConnections()
+
+	return err
 }
 
-func put(opt requestOptions) ([]byte, error) {
-	buf := new(bytes.Buffer)
-	if opt.fields != nil {
-		bodyJSON, err := json.Marshal(opt.fields)
-		if err != nil {
-			return nil, fmt.Errorf("json marshal: %w", err)
-		}
-		buf = bytes.NewBuffer(bodyJSON)
-	}
-	req, err := http.NewRequestWithContext(opt.ctx, "PUT", opt.endpoint, buf)
-	if err != nil {
-		return nil, fmt.Errorf("new request: %w", err)
-	}
-	req.Header.Set("content-type", "application/json")
-	req.Header.Set("X-Api-Key", opt.apiKey)
-	if opt.params != nil {
-		req.URL.RawQuery = opt.params.Encode()
-	}
+func (r *requester) get(opt requestOptions) ([]byte, error) {
+	return r.do(http.MethodGet, opt)
+}
 
-	client := http.Client{}
-	resp, err := client.Do(req)
-	if err != nil {
-		return nil, fmt.Errorf("do: %w", err)
+func (r *requester) post(opt requestOptions) ([]byte, error) {
+	return r.do(http.MethodPost, opt)
+}
+
+func (r *requester) put(opt requestOptions) ([]byte, error) {
+	return r.do(http.MethodPut, opt)
+}
+
+func (r *requester) patch(opt requestOptions) ([]byte, error) {
+	return r.do(http.MethodPatch, opt)
+}
+
+func (r *requester) del(opt requestOptions) ([]byte, error) {
+	return r.do(http.MethodDelete, opt)
+}
+
+func (r *requester) do(method string, opt requestOptions) ([]byte, error) {
+	if err := r.begin(); err != nil {
+		return nil, err
 	}
-	defer func() {
-		if err := resp.Body.Close(); err != nil {
-			log.Printf(fmt.Errorf("close body: %w", err).Error())
-		}
-	}()
+	defer r.inFlight.Done()
 
-	if resp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("http error: status code %d", resp.StatusCode)
+	timeout := opt.timeout
+	if timeout == 0 {
+		timeout = r.timeouts[operationOf(method, opt)]
+	}
+	if timeout > 0 {
+		ctx, cancel := context.WithTimeout(opt.ctx, timeout)
+		defer cancel()
+		opt.ctx = ctx
 	}
 
-	respBytes, err := ioutil.ReadAll(resp.Body)
+	if r.credential == nil {
+		return nil, ErrNoCredential
+	}
+	apiKey, err := r.credential.APIKey(opt.ctx)
 	if err != nil {
-		return nil, fmt.Errorf("read body: %w", err)
+		return nil, fmt.Errorf("api key: %w", err)
 	}
-	return respBytes, nil
-}
 
-func patch(opt requestOptions) ([]byte, error) {
-	buf := new(bytes.Buffer)
+	var bodyJSON []byte
+	var body io.Reader
 	if opt.fields != nil {
-		bodyJSON, err := json.Marshal(opt.fields)
+		bodyJSON, err = json.Marshal(opt.fields)
 		if err != nil {
 			return nil, fmt.Errorf("json marshal: %w", err)
 		}
-		buf = bytes.NewBuffer(bodyJSON)
+		body = bytes.NewBuffer(bodyJSON)
 	}
-	req, err := http.NewRequestWithContext(opt.ctx, "PATCH", opt.endpoint, buf)
+	req, err := http.NewRequestWithContext(opt.ctx, method, opt.endpoint, body)
 	if err != nil {
 		return nil, fmt.Errorf("new request: %w", err)
 	}
 	req.Header.Set("content-type", "application/json")
-	req.Header.Set("X-Api-Key", opt.apiKey)
+	req.Header.Set("X-Api-Key", apiKey)
 	if opt.params != nil {
 		req.URL.RawQuery = opt.params.Encode()
 	}
 
-	client := http.Client{}
-	resp, err := client.Do(req)
-	if err != nil {
-		return nil, fmt.Errorf("do: %w", err)
+	start := time.Now()
+	respBytes, statusCode, err := r.send(req)
+	if r.debug.enabled(opt.resource) {
+		r.debug.dump(req, bodyJSON, statusCode, respBytes, time.Since(start), err)
 	}
-	defer func() {
-		if err := resp.Body.Close(); err != nil {
-			log.Printf(fmt.Errorf("close body: %w", err).Error())
-		}
-	}()
-
-	if resp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("http error: status code %d", resp.StatusCode)
+	if err != nil {
+		return nil, err
 	}
 
-	respBytes, err := ioutil.ReadAll(resp.Body)
-	if err != nil {
-		return nil, fmt.Errorf("read body: %w", err)
+	if statusCode != http.StatusOK && statusCode != http.StatusNoContent &&
+		!(method == http.MethodPost && statusCode == http.StatusCreated) {
+		return nil, &HTTPError{StatusCode: statusCode}
 	}
 	return respBytes, nil
 }
 
-func del(opt requestOptions) ([]byte, error) {
-	req, err := http.NewRequestWithContext(opt.ctx, "DELETE", opt.endpoint, nil)
+func (r *requester) send(req *http.Request) ([]byte, int, error) {
+	resp, err := r.client.Do(req)
 	if err != nil {
-		return nil, fmt.Errorf("new request: %w", err)
-	}
-	req.Header.Set("content-type", "application/json")
-	req.Header.Set("X-Api-Key", opt.apiKey)
-	client := http.Client{}
-	resp, err := client.Do(req)
-	if err != nil {
-		return nil, fmt.Errorf("do: %w", err)
+		return nil, 0, fmt.Errorf("do: %w", err)
 	}
 	defer func() {
 		if err := resp.Body.Close(); err != nil {
@@ -276,19 +381,22 @@ func del(opt requestOptions) ([]byte, error) {
 		}
 	}()
 
-	if resp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("http error: status code %d", resp.StatusCode)
+	// Error status is reported as HTTPError by do, whatever its content type.
+	if resp.StatusCode >= 200 && resp.StatusCode < 300 {
+		if err := checkContentType(resp); err != nil {
+			return nil, resp.StatusCode, err
+		}
 	}
-
-	respBytes, err := ioutil.ReadAll(resp.Body)
+	respBytes, err := readBody(resp.Body, r.response.maxSize)
 	if err != nil {
-		return nil, fmt.Errorf("read body: %w", err)
+		return nil, resp.StatusCode, fmt.Errorf("read body: %w", err)
 	}
-	return respBytes, nil
+	return respBytes, resp.StatusCode, nil
 }
 
 type contextOptions struct {
-	ctx context.Context
+	ctx     context.Context
+	timeout time.Duration
 }
 
 func injectContext(requestOptions *requestOptions, opts []RequestOption) {
@@ -299,6 +407,39 @@ func injectContext(requestOptions *requestOptions, opts []RequestOption) {
 		}
 	}
 	requestOptions.ctx = co.ctx
+	requestOptions.timeout = co.timeout
+}
+
+// splitArray split value joined with arraySeparator. Empty value result in
+// empty slice rather than slice with one empty string.
+func splitArray(value string) []string {
+	if value == "" {
+		return []string{}
+	}
+	return strings.Split(value, arraySeparator)
+}
+
+// enumUnknown is the Unknown value of every enum, eg: TaskStatusUnknown.
+const enumUnknown = "UNKNOWN"
+
+// unmarshalEnum decode JSON string into one of known values. Value unknown to
+// this package decode into fallback, so new value introduced by Clockify
+// doesn't break decoding. Non-string JSON is rejected. The raw value is kept in
+// Extra of the model holding it when WithPreserveUnknownFields is set.
+func unmarshalEnum(data []byte, fallback string, known ...string) (string, error) {
+	var s *string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return "", err
+	}
+	if s == nil || *s == "" {
+		return "", nil
+	}
+	for _, k := range known {
+		if *s == k {
+			return *s, nil
+		}
+	}
+	return fallback, nil
 }
 
 // WithContext set request context. Default to context.Background.
@@ -310,6 +451,20 @@ func WithContext(ctx context.Context) RequestOption {
 	}
 }
 
+// NoTimeout when given to WithTimeout, disable default timeout of request.
+const NoTimeout time.Duration = -1
+
+// WithTimeout set request timeout, overriding default timeout of its
+// Operation set by WithDefaultTimeout. Zero timeout keep the default, while
+// negative timeout such as NoTimeout disable it for this request.
+func WithTimeout(timeout time.Duration) RequestOption {
+	return RequestOption{
+		contextProvider: func(o *contextOptions) {
+			o.timeout = timeout
+		},
+	}
+}
+
 const (
 	defaultPage      = 1
 	defaultPageSize  = 50
@@ -331,7 +486,7 @@ type SortOrderValue string
 // Possible value for SortOrderValue
 const (
 	SortOrderAscending  SortOrderValue = "ASCENDING"
-	SortOrderDescending                = "DESCENDING"
+	SortOrderDescending SortOrderValue = "DESCENDING"
 )
 
 // WithPage set request's page. Default to 1.
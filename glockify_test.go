@@ -2,6 +2,7 @@ package glockify
 
 import (
 	"net/http"
+	"net/http/httptest"
 )
 
 const (
@@ -12,3 +13,12 @@ func checkAuthHeader(r *http.Request) bool {
 	api := r.Header.Get("X-Api-Key")
 	return api == dummyAPIKey
 }
+
+// newJSONServer start test server responding with JSON content type, as
+// Clockify does, unless handler set other content type.
+func newJSONServer(handler http.Handler) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		handler.ServeHTTP(w, r)
+	}))
+}
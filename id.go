@@ -0,0 +1,130 @@
+package glockify
+
+// Distinct ID types prevent passing one resource ID where another is expected,
+// eg: swapping workspace and project ID. Untyped string constants are still
+// accepted, while string variables need explicit conversion such as
+// WorkspaceID(s).
+type (
+	// WorkspaceID identify Workspace.
+	WorkspaceID string
+	// ClientID identify Client.
+	ClientID string
+	// ProjectID identify Project.
+	ProjectID string
+	// TaskID identify Task.
+	TaskID string
+	// UserID identify Clockify's user.
+	UserID string
+	// UserGroupID identify Clockify's user group.
+	UserGroupID string
+	// TimeEntryID identify TimeEntry.
+	TimeEntryID string
+	// TagID identify Tag.
+	TagID string
+	// CustomFieldID identify CustomField.
+	CustomFieldID string
+	// WebhookID identify Webhook.
+	WebhookID string
+)
+
+// String return id as string.
+func (id WorkspaceID) String() string { return string(id) }
+
+// String return id as string.
+func (id ClientID) String() string { return string(id) }
+
+// String return id as string.
+func (id ProjectID) String() string { return string(id) }
+
+// String return id as string.
+func (id TaskID) String() string { return string(id) }
+
+// String return id as string.
+func (id UserID) String() string { return string(id) }
+
+// String return id as string.
+func (id UserGroupID) String() string { return string(id) }
+
+// String return id as string.
+func (id TimeEntryID) String() string { return string(id) }
+
+// String return id as string.
+func (id TagID) String() string { return string(id) }
+
+// String return id as string.
+func (id CustomFieldID) String() string { return string(id) }
+
+// String return id as string.
+func (id WebhookID) String() string { return string(id) }
+
+// ClientIDs convert string ids into ClientID.
+func ClientIDs(ids ...string) []ClientID {
+	if ids == nil {
+		return nil
+	}
+	res := make([]ClientID, len(ids))
+	for i, id := range ids {
+		res[i] = ClientID(id)
+	}
+	return res
+}
+
+// ProjectIDs convert string ids into ProjectID.
+func ProjectIDs(ids ...string) []ProjectID {
+	if ids == nil {
+		return nil
+	}
+	res := make([]ProjectID, len(ids))
+	for i, id := range ids {
+		res[i] = ProjectID(id)
+	}
+	return res
+}
+
+// TaskIDs convert string ids into TaskID.
+func TaskIDs(ids ...string) []TaskID {
+	if ids == nil {
+		return nil
+	}
+	res := make([]TaskID, len(ids))
+	for i, id := range ids {
+		res[i] = TaskID(id)
+	}
+	return res
+}
+
+// UserIDs convert string ids into UserID.
+func UserIDs(ids ...string) []UserID {
+	if ids == nil {
+		return nil
+	}
+	res := make([]UserID, len(ids))
+	for i, id := range ids {
+		res[i] = UserID(id)
+	}
+	return res
+}
+
+// UserGroupIDs convert string ids into UserGroupID.
+func UserGroupIDs(ids ...string) []UserGroupID {
+	if ids == nil {
+		return nil
+	}
+	res := make([]UserGroupID, len(ids))
+	for i, id := range ids {
+		res[i] = UserGroupID(id)
+	}
+	return res
+}
+
+// TagIDs convert string ids into TagID.
+func TagIDs(ids ...string) []TagID {
+	if ids == nil {
+		return nil
+	}
+	res := make([]TagID, len(ids))
+	for i, id := range ids {
+		res[i] = TagID(id)
+	}
+	return res
+}
@@ -0,0 +1,36 @@
+package glockify
+
+import (
+	"encoding/json"
+	"github.com/stretchr/testify/suite"
+	"testing"
+)
+
+type IDTestSuite struct {
+	suite.Suite
+}
+
+func (s *IDTestSuite) TestConvert() {
+	s.Require().Nil(UserIDs())
+	s.Require().Equal([]UserID{"User1", "User2"}, UserIDs("User1", "User2"))
+	s.Require().Equal([]ClientID{"Client1"}, ClientIDs("Client1"))
+	s.Require().Equal([]UserGroupID{"Group1"}, UserGroupIDs("Group1"))
+	s.Require().Equal([]ProjectID{"Project1", "Project2"}, ProjectIDs("Project1", "Project2"))
+	s.Require().Equal([]TaskID{"Task1"}, TaskIDs("Task1"))
+	s.Require().Nil(TaskIDs())
+	s.Require().Equal("Workspace1", WorkspaceID("Workspace1").String())
+}
+
+func (s *IDTestSuite) TestUnmarshal() {
+	task := Task{}
+	s.Require().Nil(json.Unmarshal([]byte(`{"id":"Task1","projectId":"Project1",`+
+		`"assigneeIds":["User1"],"userGroupIds":["Group1"]}`), &task))
+	s.Require().Equal(TaskID("Task1"), task.ID)
+	s.Require().Equal(ProjectID("Project1"), task.ProjectID)
+	s.Require().Equal([]UserID{"User1"}, task.AssigneeIds)
+	s.Require().Equal([]UserGroupID{"Group1"}, task.UserGroupIds)
+}
+
+func TestID(t *testing.T) {
+	suite.Run(t, &IDTestSuite{})
+}
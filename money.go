@@ -0,0 +1,245 @@
+package glockify
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// Money represent amount of money in minor units of its ISO 4217 currency,
+// eg: Amount 2050 with Currency "USD" is 20.50 USD.
+// See: https://clockify.me/developers-api#tag-Workspace
+type Money struct {
+	Amount   int64  `json:"amount"`
+	Currency string `json:"currency,omitempty"`
+}
+
+// HourlyRate is kept for compatibility.
+//
+// Deprecated: use Money.
+type HourlyRate = Money
+
+// CostRate is kept for compatibility.
+//
+// Deprecated: use Money.
+type CostRate = Money
+
+// Possible errors of Money arithmetic.
+var (
+	ErrCurrencyMismatch = errors.New("currency mismatch")
+	ErrMoneyOverflow    = errors.New("money overflow")
+)
+
+// currencyExponents list currencies which minor unit isn't 1/100 of major unit.
+var currencyExponents = map[string]int{
+	"BIF": 0, "CLP": 0, "DJF": 0, "GNF": 0, "ISK": 0, "JPY": 0, "KMF": 0, "KRW": 0,
+	"PYG": 0, "RWF": 0, "UGX": 0, "UYI": 0, "VND": 0, "VUV": 0, "XAF": 0, "XOF": 0,
+	"XPF": 0,
+	"BHD": 3, "IQD": 3, "JOD": 3, "KWD": 3, "LYD": 3, "OMR": 3, "TND": 3,
+}
+
+// NewMoney instantiate Money with amount in minor units of currency given.
+func NewMoney(amount int64, currency string) Money {
+	return Money{Amount: amount, Currency: strings.ToUpper(currency)}
+}
+
+// ParseMoney parse decimal amount in major units, eg: "20.50", into Money.
+func ParseMoney(amount string, currency string) (Money, error) {
+	minor, err := parseMajorUnits(amount, currencyExponent(currency))
+	if err != nil {
+		return Money{}, err
+	}
+	return NewMoney(minor, currency), nil
+}
+
+func currencyExponent(currency string) int {
+	if exp, ok := currencyExponents[strings.ToUpper(currency)]; ok {
+		return exp
+	}
+	return 2
+}
+
+func parseMajorUnits(amount string, exponent int) (int64, error) {
+	amount = strings.TrimSpace(amount)
+	neg := strings.HasPrefix(amount, "-")
+	amount = strings.TrimPrefix(amount, "-")
+	whole, frac := amount, ""
+	if i := strings.IndexByte(amount, '.'); i >= 0 {
+		whole, frac = amount[:i], amount[i+1:]
+	}
+	if whole == "" && frac == "" {
+		return 0, fmt.Errorf("invalid amount %q: empty", amount)
+	}
+	if strings.HasPrefix(whole, "-") || strings.HasPrefix(whole, "+") ||
+		strings.HasPrefix(frac, "-") || strings.HasPrefix(frac, "+") {
+		return 0, fmt.Errorf("invalid amount %q: unexpected sign", amount)
+	}
+	if len(frac) > exponent {
+		return 0, fmt.Errorf("invalid amount %q: too many decimal places", amount)
+	}
+	frac += strings.Repeat("0", exponent-len(frac))
+	if whole == "" {
+		whole = "0"
+	}
+	minor, err := strconv.ParseInt(whole+frac, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid amount %q: %w", amount, err)
+	}
+	if neg {
+		minor = -minor
+	}
+	return minor, nil
+}
+
+// IsZero report whether m has zero amount.
+func (m Money) IsZero() bool {
+	return m.Amount == 0
+}
+
+// Add return m + o. Currencies must be equal, unless one of them is empty.
+func (m Money) Add(o Money) (Money, error) {
+	currency, err := m.commonCurrency(o)
+	if err != nil {
+		return Money{}, err
+	}
+	if (o.Amount > 0 && m.Amount > math.MaxInt64-o.Amount) ||
+		(o.Amount < 0 && m.Amount < math.MinInt64-o.Amount) {
+		return Money{}, ErrMoneyOverflow
+	}
+	return Money{Amount: m.Amount + o.Amount, Currency: currency}, nil
+}
+
+// Sub return m - o. Currencies must be equal, unless one of them is empty.
+func (m Money) Sub(o Money) (Money, error) {
+	if o.Amount == math.MinInt64 {
+		return Money{}, ErrMoneyOverflow
+	}
+	return m.Add(Money{Amount: -o.Amount, Currency: o.Currency})
+}
+
+// Mul return m multiplied by n.
+func (m Money) Mul(n int64) (Money, error) {
+	if m.Amount == 0 || n == 0 {
+		return Money{Currency: m.Currency}, nil
+	}
+	result := m.Amount * n
+	if (n == -1 && m.Amount == math.MinInt64) || result/n != m.Amount {
+		return Money{}, ErrMoneyOverflow
+	}
+	return Money{Amount: result, Currency: m.Currency}, nil
+}
+
+func (m Money) commonCurrency(o Money) (string, error) {
+	switch {
+	case m.Currency == "":
+		return o.Currency, nil
+	case o.Currency == "", strings.EqualFold(m.Currency, o.Currency):
+		return m.Currency, nil
+	}
+	return "", fmt.Errorf("%w: %s and %s", ErrCurrencyMismatch, m.Currency, o.Currency)
+}
+
+// Decimal format amount in major units, eg: "20.50".
+func (m Money) Decimal() string {
+	exponent := currencyExponent(m.Currency)
+	amount := m.Amount
+	sign := ""
+	if amount < 0 {
+		sign = "-"
+	}
+	digits := strconv.FormatUint(absInt64(amount), 10)
+	if exponent == 0 {
+		return sign + digits
+	}
+	if len(digits) <= exponent {
+		digits = strings.Repeat("0", exponent-len(digits)+1) + digits
+	}
+	return sign + digits[:len(digits)-exponent] + "." + digits[len(digits)-exponent:]
+}
+
+func absInt64(v int64) uint64 {
+	if v < 0 {
+		return uint64(-(v + 1)) + 1
+	}
+	return uint64(v)
+}
+
+// String format m in major units followed by its currency, eg: "20.50 USD".
+func (m Money) String() string {
+	if m.Currency == "" {
+		return m.Decimal()
+	}
+	return m.Decimal() + " " + m.Currency
+}
+
+// UnmarshalJSON decode Money from Clockify's mixed representations: object
+// with amount as number or string, or bare number. Number and integer string
+// are minor units, while decimal string such as "20.50" is major units.
+func (m *Money) UnmarshalJSON(data []byte) error {
+	data = bytes.TrimSpace(data)
+	if bytes.Equal(data, []byte("null")) {
+		*m = Money{}
+		return nil
+	}
+	if len(data) > 0 && data[0] != '{' {
+		amount, err := parseMoneyAmount(data, "")
+		if err != nil {
+			return err
+		}
+		*m = Money{Amount: amount}
+		return nil
+	}
+
+	var raw struct {
+		Amount   json.RawMessage `json:"amount"`
+		Currency string          `json:"currency"`
+	}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+	amount, err := parseMoneyAmount(raw.Amount, raw.Currency)
+	if err != nil {
+		return err
+	}
+	*m = Money{Amount: amount, Currency: raw.Currency}
+	return nil
+}
+
+func parseMoneyAmount(data json.RawMessage, currency string) (int64, error) {
+	data = bytes.TrimSpace(data)
+	if len(data) == 0 || bytes.Equal(data, []byte("null")) {
+		return 0, nil
+	}
+	if data[0] == '"' {
+		var s string
+		if err := json.Unmarshal(data, &s); err != nil {
+			return 0, err
+		}
+		s = strings.TrimSpace(s)
+		switch {
+		case s == "":
+			return 0, nil
+		case strings.Contains(s, "."):
+			return parseMajorUnits(s, currencyExponent(currency))
+		}
+		amount, err := strconv.ParseInt(s, 10, 64)
+		if err != nil {
+			return 0, fmt.Errorf("invalid amount %q: %w", s, err)
+		}
+		return amount, nil
+	}
+
+	amount, err := strconv.ParseInt(string(data), 10, 64)
+	if err == nil {
+		return amount, nil
+	}
+	f, fErr := strconv.ParseFloat(string(data), 64)
+	if fErr != nil || math.Abs(f) >= math.MaxInt64 {
+		return 0, fmt.Errorf("invalid amount %s", data)
+	}
+	return int64(math.Round(f)), nil
+}
@@ -0,0 +1,110 @@
+package glockify
+
+import (
+	"encoding/json"
+	"errors"
+	"github.com/stretchr/testify/suite"
+	"math"
+	"testing"
+)
+
+type MoneyTestSuite struct {
+	suite.Suite
+}
+
+var testsMoneyUnmarshal = []struct {
+	name  string
+	value string
+	want  Money
+}{
+	{name: "Object", value: `{"amount":2050,"currency":"USD"}`, want: NewMoney(2050, "USD")},
+	{name: "String Amount", value: `{"amount":"2050","currency":"USD"}`,
+		want: NewMoney(2050, "USD")},
+	{name: "Decimal String Amount", value: `{"amount":"20.5","currency":"USD"}`,
+		want: NewMoney(2050, "USD")},
+	{name: "Zero Decimal Currency", value: `{"amount":"1500.","currency":"JPY"}`,
+		want: NewMoney(1500, "JPY")},
+	{name: "Bare Number", value: `2050`, want: Money{Amount: 2050}},
+	{name: "Null", value: `null`, want: Money{}},
+}
+
+func (s *MoneyTestSuite) TestUnmarshal() {
+	for _, tc := range testsMoneyUnmarshal {
+		s.Run(tc.name, func() {
+			m := Money{}
+			s.Require().Nil(json.Unmarshal([]byte(tc.value), &m))
+			s.Require().Equal(tc.want, m)
+		})
+	}
+}
+
+var testsMoneyParse = []struct {
+	name    string
+	value   string
+	want    Money
+	wantErr bool
+}{
+	{name: "Decimal", value: "20.50", want: NewMoney(2050, "USD")},
+	{name: "Negative", value: "-1.5", want: NewMoney(-150, "USD")},
+	{name: "Fraction Only", value: ".5", want: NewMoney(50, "USD")},
+	{name: "Double Sign", value: "--5", wantErr: true},
+	{name: "Plus After Minus", value: "-+5", wantErr: true},
+	{name: "Sign Only", value: "-", wantErr: true},
+	{name: "Empty", value: "", wantErr: true},
+	{name: "Dot Only", value: ".", wantErr: true},
+	{name: "Too Many Decimal Places", value: "1.005", wantErr: true},
+}
+
+func (s *MoneyTestSuite) TestParse() {
+	for _, tc := range testsMoneyParse {
+		s.Run(tc.name, func() {
+			m, err := ParseMoney(tc.value, "USD")
+			if tc.wantErr {
+				s.Require().NotNil(err)
+				return
+			}
+			s.Require().Nil(err)
+			s.Require().Equal(tc.want, m)
+		})
+	}
+}
+
+func (s *MoneyTestSuite) TestModels() {
+	tasks := Tasks{}
+	err := json.Unmarshal([]byte(`{"hourlyRate":1000,"costRate":500}`), &tasks)
+	s.Require().Nil(err)
+	s.Require().Equal(int64(1000), tasks.HourlyRate.Amount)
+	s.Require().Equal(int64(500), tasks.CostRate.Amount)
+
+	task := Task{}
+	err = json.Unmarshal([]byte(`{"costRate":{"amount":"12.34","currency":"EUR"}}`), &task)
+	s.Require().Nil(err)
+	s.Require().Equal(NewMoney(1234, "EUR"), task.CostRate)
+}
+
+func (s *MoneyTestSuite) TestArithmetic() {
+	sum, err := NewMoney(1050, "USD").Add(NewMoney(250, "usd"))
+	s.Require().Nil(err)
+	s.Require().Equal("13.00 USD", sum.String())
+
+	diff, err := NewMoney(100, "USD").Sub(NewMoney(250, "USD"))
+	s.Require().Nil(err)
+	s.Require().Equal("-1.50 USD", diff.String())
+
+	_, err = NewMoney(100, "USD").Add(NewMoney(100, "EUR"))
+	s.Require().True(errors.Is(err, ErrCurrencyMismatch))
+
+	_, err = NewMoney(math.MaxInt64, "USD").Add(NewMoney(1, "USD"))
+	s.Require().True(errors.Is(err, ErrMoneyOverflow))
+
+	_, err = NewMoney(math.MaxInt64/2+1, "USD").Mul(2)
+	s.Require().True(errors.Is(err, ErrMoneyOverflow))
+
+	product, err := NewMoney(5, "KWD").Mul(3)
+	s.Require().Nil(err)
+	s.Require().Equal("0.015 KWD", product.String())
+}
+
+func TestMoney(t *testing.T) {
+	suite.Run(t, &MoneyTestSuite{})
+}
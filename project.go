@@ -10,87 +10,95 @@ import (
 
 // ProjectNode manipulating Project resource.
 type ProjectNode struct {
-	endpoint string
-	apiKey   string
+	endpoint  string
+	requester *requester
 }
 
 // Project represent Clockify's project resource.
 // See: https://clockify.me/developers-api#tag-Project
 type Project struct {
-	ID             string         `json:"id,omitempty"`
+	ID             ProjectID      `json:"id,omitempty"`
 	Name           string         `json:"name,omitempty"`
-	HourlyRate     HourlyRate     `json:"hourlyRate,omitempty"`
-	ClientID       string         `json:"clientId,omitempty"`
+	HourlyRate     Money          `json:"hourlyRate,omitempty"`
+	ClientID       ClientID       `json:"clientId,omitempty"`
 	Client         string         `json:"client,omitempty"`
-	WorkspaceID    string         `json:"workspaceId,omitempty"`
+	WorkspaceID    WorkspaceID    `json:"workspaceId,omitempty"`
 	Billable       bool           `json:"billable,omitempty"`
 	Memberships    []Memberships  `json:"memberships,omitempty"`
 	Color          string         `json:"color,omitempty"`
 	Estimate       Estimate       `json:"estimate,omitempty"`
 	Archived       bool           `json:"archived,omitempty"`
-	Tasks          []Tasks        `json:"tasks,omitempty"`
+	Tasks          []Task         `json:"tasks,omitempty"`
 	Note           string         `json:"note,omitempty"`
-	Duration       string         `json:"duration,omitempty"`
-	CostRate       int            `json:"costRate,omitempty"`
+	Duration       Duration       `json:"duration,omitempty"`
+	CostRate       Money          `json:"costRate,omitempty"`
 	TimeEstimate   TimeEstimate   `json:"timeEstimate,omitempty"`
 	BudgetEstimate BudgetEstimate `json:"budgetEstimate"`
 	CustomFields   []CustomFields `json:"customFields,omitempty"`
 	Public         bool           `json:"public,omitempty"`
 	Template       bool           `json:"template,omitempty"`
 	Favorite       bool           `json:"favorite,omitempty"`
+
+	// Extra holds JSON properties unknown to this package.
+	// See: WithPreserveUnknownFields.
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // Estimate wraps Clockify's estimate resource.
 // See: https://clockify.me/developers-api#tag-Project
 type Estimate struct {
-	Estimate string `json:"estimate,omitempty"`
-	Type     string `json:"type,omitempty"`
+	Estimate Duration     `json:"estimate,omitempty"`
+	Type     EstimateType `json:"type,omitempty"`
 }
 
-// Tasks wraps Clockify's tasks resource.
-// See: https://clockify.me/developers-api#tag-Project
-type Tasks struct {
-	ID           string   `json:"id,omitempty"`
-	Name         string   `json:"name,omitempty"`
-	ProjectID    string   `json:"projectId,omitempty"`
-	AssigneeIds  []string `json:"assigneeIds,omitempty"`
-	AssigneeID   string   `json:"assigneeId,omitempty"`
-	UserGroupIds []string `json:"userGroupIds,omitempty"`
-	Estimate     string   `json:"estimate,omitempty"`
-	Status       string   `json:"status,omitempty"`
-	Duration     int      `json:"duration,omitempty"`
-	Billable     bool     `json:"billable,omitempty"`
-	HourlyRate   int      `json:"hourlyRate,omitempty"`
-	CostRate     int      `json:"costRate,omitempty"`
-}
+// Tasks is kept for compatibility.
+//
+// Deprecated: use Task.
+type Tasks = Task
 
 // TimeEstimate wraps Clockify's time estimate resource.
 // See: https://clockify.me/developers-api#tag-Project
 type TimeEstimate struct {
-	Estimate           string `json:"estimate,omitempty"`
-	Type               string `json:"type,omitempty"`
-	ResetOption        string `json:"resetOption,omitempty"`
-	Active             bool   `json:"active,omitempty"`
-	IncludeNonBillable bool   `json:"includeNonBillable,omitempty"`
+	Estimate           Duration            `json:"estimate,omitempty"`
+	Type               EstimateType        `json:"type,omitempty"`
+	ResetOption        EstimateResetOption `json:"resetOption,omitempty"`
+	Active             bool                `json:"active,omitempty"`
+	IncludeNonBillable bool                `json:"includeNonBillable,omitempty"`
 }
 
-// CustomFields wraps Clockify's custom fields resource.
+// CustomFields wraps Clockify's custom fields resource. Use typed accessors
+// such as Text or DropdownMultiple to read Value according to Type.
 // See: https://clockify.me/developers-api#tag-Project
 type CustomFields struct {
-	CustomFieldID string      `json:"customFieldId,omitempty"`
-	Name          string      `json:"name,omitempty"`
-	Type          string      `json:"type,omitempty"`
-	Value         interface{} `json:"value,omitempty"`
-	Status        string      `json:"status,omitempty"`
+	CustomFieldID CustomFieldID     `json:"customFieldId,omitempty"`
+	Name          string            `json:"name,omitempty"`
+	Type          CustomFieldType   `json:"type,omitempty"`
+	Value         interface{}       `json:"value,omitempty"`
+	Status        CustomFieldStatus `json:"status,omitempty"`
 }
 
 // BudgetEstimate wraps Clockify's budget estimate resource.
 // See: https://clockify.me/developers-api#tag-Project
 type BudgetEstimate struct {
-	Estimate    string `json:"estimate,omitempty"`
-	Type        string `json:"type,omitempty"`
-	ResetOption string `json:"resetOption,omitempty"`
-	Active      bool   `json:"active,omitempty"`
+	Estimate    string              `json:"estimate,omitempty"`
+	Type        EstimateType        `json:"type,omitempty"`
+	ResetOption EstimateResetOption `json:"resetOption,omitempty"`
+	Active      bool                `json:"active,omitempty"`
+}
+
+// CustomField find custom field of hydrated project by its id or name.
+func (p Project) CustomField(idOrName string) (CustomFields, bool) {
+	for _, c := range p.CustomFields {
+		if string(c.CustomFieldID) == idOrName {
+			return c, true
+		}
+	}
+	for _, c := range p.CustomFields {
+		if c.Name == idOrName {
+			return c, true
+		}
+	}
+	return CustomFields{}, false
 }
 
 const (
@@ -118,7 +126,7 @@ type ClientStatus string
 // Possible values of ClientStatus
 const (
 	ClientStatusActive   ClientStatus = "ACTIVE"
-	ClientStatusArchived              = "ARCHIVED"
+	ClientStatusArchived ClientStatus = "ARCHIVED"
 )
 
 type UserStatus string
@@ -126,17 +134,64 @@ type UserStatus string
 // Possible values of UserStatus
 const (
 	UserStatusActive   UserStatus = "ACTIVE"
-	UserStatusInactive            = "INACTIVE"
+	UserStatusInactive UserStatus = "INACTIVE"
 )
 
 type EstimateType string
 
 // Possible values of EstimateType
 const (
-	EstimateTypeManual EstimateType = "MANUAL"
-	EstimateTypeAuto                = "AUTO"
+	EstimateTypeManual  EstimateType = "MANUAL"
+	EstimateTypeAuto    EstimateType = "AUTO"
+	EstimateTypeUnknown EstimateType = enumUnknown
+)
+
+// UnmarshalJSON decode EstimateType, unknown value decode into
+// EstimateTypeUnknown.
+func (e *EstimateType) UnmarshalJSON(data []byte) error {
+	v, err := unmarshalEnum(data, string(EstimateTypeUnknown), string(EstimateTypeManual),
+		string(EstimateTypeAuto))
+	*e = EstimateType(v)
+	return err
+}
+
+type EstimateResetOption string
+
+// Possible values of EstimateResetOption
+const (
+	EstimateResetOptionMonthly EstimateResetOption = "MONTHLY"
+	EstimateResetOptionUnknown EstimateResetOption = enumUnknown
+)
+
+// UnmarshalJSON decode EstimateResetOption, unknown value decode into
+// EstimateResetOptionUnknown.
+func (e *EstimateResetOption) UnmarshalJSON(data []byte) error {
+	v, err := unmarshalEnum(data, string(EstimateResetOptionUnknown),
+		string(EstimateResetOptionMonthly))
+	*e = EstimateResetOption(v)
+	return err
+}
+
+type CustomFieldStatus string
+
+// Possible values of CustomFieldStatus
+const (
+	CustomFieldStatusInactive  CustomFieldStatus = "INACTIVE"
+	CustomFieldStatusVisible   CustomFieldStatus = "VISIBLE"
+	CustomFieldStatusInvisible CustomFieldStatus = "INVISIBLE"
+	CustomFieldStatusUnknown   CustomFieldStatus = enumUnknown
 )
 
+// UnmarshalJSON decode CustomFieldStatus, unknown value decode into
+// CustomFieldStatusUnknown.
+func (c *CustomFieldStatus) UnmarshalJSON(data []byte) error {
+	v, err := unmarshalEnum(data, string(CustomFieldStatusUnknown),
+		string(CustomFieldStatusInactive), string(CustomFieldStatusVisible),
+		string(CustomFieldStatusInvisible))
+	*c = CustomFieldStatus(v)
+	return err
+}
+
 // WithHydrated if set to true, projects returned will contain custom fields,
 // task and memberships. Default to false.
 func WithHydrated(hydrated bool) RequestOption {
@@ -150,11 +205,11 @@ func WithHydrated(hydrated bool) RequestOption {
 
 // WithClients if set, projects will be filtered by client IDs.
 // Filter behaviour depends on the WithContainsClient.
-func WithClients(ids []string) RequestOption {
+func WithClients(ids []ClientID) RequestOption {
 	return RequestOption{
 		paramsProvider: func(v url.Values) string {
 			for _, id := range ids {
-				v.Add(clientsKey, id)
+				v.Add(clientsKey, string(id))
 			}
 			return clientsKey
 		},
@@ -184,11 +239,11 @@ func WithClientStatus(status ClientStatus) RequestOption {
 
 // WithUsers if set, projects will be filtered by user IDs who have access.
 // Filter behaviour depends on the WithContainsUser.
-func WithUsers(ids []string) RequestOption {
+func WithUsers(ids []UserID) RequestOption {
 	return RequestOption{
 		paramsProvider: func(v url.Values) string {
 			for _, id := range ids {
-				v.Add(usersKey, id)
+				v.Add(usersKey, string(id))
 			}
 			return usersKey
 		},
@@ -241,11 +296,11 @@ func WithEstimateType(estimateType EstimateType) RequestOption {
 	}
 }
 
-// WithClientID set project's client id.
-func WithClientID(id string) RequestOption {
+// WithClientID set project's client id. Empty id removes client from project.
+func WithClientID(id ClientID) RequestOption {
 	return RequestOption{
 		paramsProvider: func(v url.Values) string {
-			v.Set(clientIDKey, id)
+			v.Set(clientIDKey, string(id))
 			return clientIDKey
 		},
 	}
@@ -282,7 +337,7 @@ func WithNote(note string) RequestOption {
 }
 
 // WithHourlyRate set project's hourly rates.
-func WithHourlyRate(rate HourlyRate) RequestOption {
+func WithHourlyRate(rate Money) RequestOption {
 	return RequestOption{
 		paramsProvider: func(v url.Values) string {
 			hr, err := json.Marshal(rate)
@@ -323,8 +378,14 @@ func WithBudgetEstimate(estimate BudgetEstimate) RequestOption {
 	}
 }
 
-// WithMemberships set project's membership state.
-func WithMemberships(memberships Memberships) RequestOption {
+// WithMemberships set project's memberships. Memberships given by multiple
+// WithMemberships are sent together, and WithMemberships without memberships
+// send empty list, which removes every membership.
+//
+// Breaking change: memberships are sent as list, as ProjectNode.UpdateMemberships
+// requires, instead of single object. Calling it with one Memberships, like
+// before, still compiles and sends list of that membership.
+func WithMemberships(memberships ...Memberships) RequestOption {
 	return RequestOption{
 		paramsProvider: func(v url.Values) string {
 			m, err := json.Marshal(memberships)
@@ -341,8 +402,8 @@ type ProjectSortColumn string
 
 const (
 	ProjectSortColumnName       ProjectSortColumn = "NAME"
-	ProjectSortColumnClientName                   = "CLIENT_NAME"
-	ProjectSortColumnDuration                     = "DURATION"
+	ProjectSortColumnClientName ProjectSortColumn = "CLIENT_NAME"
+	ProjectSortColumnDuration   ProjectSortColumn = "DURATION"
 )
 
 // WithProjectSortColumn set fields you want to sort against.
@@ -366,14 +427,14 @@ type projectAddFields struct {
 }
 
 type projectUpdateFields struct {
-	Name       string      `json:"name,omitempty"`
-	ClientID   string      `json:"clientId,omitempty"`
-	IsPublic   *bool       `json:"isPublic,omitempty"`
-	HourlyRate *HourlyRate `json:"hourlyRate,omitempty"`
-	Color      string      `json:"color,omitempty"`
-	Note       string      `json:"note,omitempty"`
-	Billable   *bool       `json:"billable,omitempty"`
-	Archived   *bool       `json:"archived,omitempty"`
+	Name       *string `json:"name,omitempty"`
+	ClientID   *string `json:"clientId,omitempty"`
+	IsPublic   *bool   `json:"isPublic,omitempty"`
+	HourlyRate *Money  `json:"hourlyRate,omitempty"`
+	Color      *string `json:"color,omitempty"`
+	Note       *string `json:"note,omitempty"`
+	Billable   *bool   `json:"billable,omitempty"`
+	Archived   *bool   `json:"archived,omitempty"`
 }
 
 type projectUpdateEstimateFields struct {
@@ -382,7 +443,7 @@ type projectUpdateEstimateFields struct {
 }
 
 type projectUpdateMembershipsFields struct {
-	Memberships *Memberships `json:"memberships,omitempty"`
+	Memberships *[]Memberships `json:"memberships,omitempty"`
 }
 
 type projectUpdateTemplateFields struct {
@@ -390,26 +451,24 @@ type projectUpdateTemplateFields struct {
 }
 
 // All get all Project resource based on filter given.
-func (p *ProjectNode) All(workspaceID string, opts ...RequestOption) ([]Project, error) {
+func (p *ProjectNode) All(workspaceID WorkspaceID, opts ...RequestOption) ([]Project, error) {
 	endpoint := fmt.Sprintf("%s/workspaces/%s/projects", p.endpoint, workspaceID)
-	res, err := get(projectAllRequest(p.apiKey, endpoint, opts))
+	res, err := p.requester.get(projectAllRequest(endpoint, opts))
 	if err != nil {
 		return nil, fmt.Errorf("get: %w", err)
 	}
 	result := make([]Project, 0)
-	if err := json.Unmarshal(res, &result); err != nil {
-		if jErr, ok := err.(*json.UnmarshalTypeError); ok {
-			return nil, fmt.Errorf("unmarshal field %v of type %v", jErr.Field, jErr.Type)
-		}
-		return nil, fmt.Errorf("json unmarshal: %w", err)
+	if err := p.requester.unmarshal(res, &result); err != nil {
+		return nil, err
 	}
 	return result, nil
 }
 
-func projectAllRequest(apiKey string, endpoint string, options []RequestOption) requestOptions {
+func projectAllRequest(endpoint string, options []RequestOption) requestOptions {
 	res := requestOptions{
-		apiKey:   apiKey,
-		endpoint: endpoint,
+		resource:  ResourceProject,
+		operation: OperationList,
+		endpoint:  endpoint,
 	}
 	res.params = url.Values{}
 	res.params.Add(archivedKey, strconv.FormatBool(false))
@@ -417,7 +476,7 @@ func projectAllRequest(apiKey string, endpoint string, options []RequestOption)
 	res.params.Add(containsUserKey, strconv.FormatBool(true))
 	res.params.Add(pageKey, strconv.Itoa(defaultPage))
 	res.params.Add(pageSizeKey, strconv.Itoa(defaultPageSize))
-	res.params.Add(sortOrderKey, defaultSortOrder)
+	res.params.Add(sortOrderKey, string(defaultSortOrder))
 	for _, opt := range options {
 		if opt.paramsProvider != nil {
 			opt.paramsProvider(res.params)
@@ -429,25 +488,23 @@ func projectAllRequest(apiKey string, endpoint string, options []RequestOption)
 }
 
 // Get one Project by its id.
-func (p *ProjectNode) Get(workspaceID string, id string, opts ...RequestOption) (*Project, error) {
+func (p *ProjectNode) Get(workspaceID WorkspaceID, id ProjectID,
+	opts ...RequestOption) (*Project, error) {
 	endpoint := fmt.Sprintf("%s/workspaces/%s/projects/%s", p.endpoint, workspaceID, id)
-	res, err := get(projectGetRequest(p.apiKey, endpoint, opts))
+	res, err := p.requester.get(projectGetRequest(endpoint, opts))
 	if err != nil {
 		return nil, fmt.Errorf("get: %w", err)
 	}
 	result := new(Project)
-	if err := json.Unmarshal(res, &result); err != nil {
-		if jErr, ok := err.(*json.UnmarshalTypeError); ok {
-			return nil, fmt.Errorf("unmarshal field %v of type %v", jErr.Field, jErr.Type)
-		}
-		return nil, fmt.Errorf("json unmarshal: %w", err)
+	if err := p.requester.unmarshal(res, &result); err != nil {
+		return nil, err
 	}
 	return result, nil
 }
 
-func projectGetRequest(apiKey string, endpoint string, options []RequestOption) requestOptions {
+func projectGetRequest(endpoint string, options []RequestOption) requestOptions {
 	res := requestOptions{
-		apiKey:   apiKey,
+		resource: ResourceProject,
 		endpoint: endpoint,
 	}
 	res.params = url.Values{}
@@ -462,27 +519,24 @@ func projectGetRequest(apiKey string, endpoint string, options []RequestOption)
 }
 
 // Add create new Project based on fields given.
-func (p *ProjectNode) Add(workspaceID string, name string, opts ...RequestOption) (*Project,
+func (p *ProjectNode) Add(workspaceID WorkspaceID, name string, opts ...RequestOption) (*Project,
 	error) {
 	endpoint := fmt.Sprintf("%s/workspaces/%s/projects", p.endpoint, workspaceID)
-	res, err := post(projectAddRequest(p.apiKey, endpoint, name, opts))
+	res, err := p.requester.post(projectAddRequest(endpoint, name, opts))
 	if err != nil {
 		return nil, fmt.Errorf("post: %w", err)
 	}
 	result := new(Project)
-	if err := json.Unmarshal(res, &result); err != nil {
-		if jErr, ok := err.(*json.UnmarshalTypeError); ok {
-			return nil, fmt.Errorf("unmarshal field %v of type %v", jErr.Field, jErr.Type)
-		}
-		return nil, fmt.Errorf("json unmarshal: %w", err)
+	if err := p.requester.unmarshal(res, &result); err != nil {
+		return nil, err
 	}
 	return result, nil
 }
 
-func projectAddRequest(apiKey string, endpoint string, name string,
+func projectAddRequest(endpoint string, name string,
 	options []RequestOption) requestOptions {
 	res := requestOptions{
-		apiKey:   apiKey,
+		resource: ResourceProject,
 		endpoint: endpoint,
 	}
 	tr := true
@@ -514,59 +568,60 @@ func projectAddRequest(apiKey string, endpoint string, name string,
 	return res
 }
 
-// Update existing Project based on fields and options given.
-func (p *ProjectNode) Update(workspaceID string, id string, opts ...RequestOption) (*Project,
-	error) {
+// Update existing Project based on options given. Only fields set by options
+// are sent, so fields not given are left unchanged. Setting empty value,
+// eg: WithNote(""), clears the field.
+func (p *ProjectNode) Update(workspaceID WorkspaceID, id ProjectID,
+	opts ...RequestOption) (*Project, error) {
 	endpoint := fmt.Sprintf("%s/workspaces/%s/projects/%s", p.endpoint, workspaceID, id)
-	res, err := put(projectUpdateRequest(p.apiKey, endpoint, opts))
+	res, err := p.requester.put(projectUpdateRequest(endpoint, opts))
 	if err != nil {
 		return nil, fmt.Errorf("put: %w", err)
 	}
 	result := new(Project)
-	if err := json.Unmarshal(res, &result); err != nil {
-		if jErr, ok := err.(*json.UnmarshalTypeError); ok {
-			return nil, fmt.Errorf("unmarshal field %v of type %v", jErr.Field, jErr.Type)
-		}
-		return nil, fmt.Errorf("json unmarshal: %w", err)
+	if err := p.requester.unmarshal(res, &result); err != nil {
+		return nil, err
 	}
 	return result, nil
 }
 
-func projectUpdateRequest(apiKey string, endpoint string, options []RequestOption) requestOptions {
+func projectUpdateRequest(endpoint string, options []RequestOption) requestOptions {
 	res := requestOptions{
-		apiKey:   apiKey,
+		resource: ResourceProject,
 		endpoint: endpoint,
 	}
 	res.params = url.Values{}
-	res.params.Add(estimateTypeKey, EstimateTypeAuto)
 	for _, opt := range options {
 		if opt.paramsProvider != nil {
 			opt.paramsProvider(res.params)
 		}
 	}
 
-	tr := true
-	fields := projectUpdateFields{Billable: &tr}
+	fields := projectUpdateFields{}
 	for _, opt := range options {
 		if opt.paramsProvider != nil {
 			params := url.Values{}
 			key := opt.paramsProvider(params)
 			switch key {
 			case nameKey:
-				fields.Name = params.Get(key)
+				val := params.Get(key)
+				fields.Name = &val
 			case clientIDKey:
-				fields.ClientID = params.Get(key)
+				val := params.Get(key)
+				fields.ClientID = &val
 			case isPublicKey:
 				val, _ := strconv.ParseBool(params.Get(key))
 				fields.IsPublic = &val
 			case hourlyRateKey:
-				hr := &HourlyRate{}
+				hr := &Money{}
 				_ = json.Unmarshal([]byte(params.Get(key)), hr)
 				fields.HourlyRate = hr
 			case colorKey:
-				fields.Color = params.Get(key)
+				val := params.Get(key)
+				fields.Color = &val
 			case noteKey:
-				fields.Note = params.Get(key)
+				val := params.Get(key)
+				fields.Note = &val
 			case billableKey:
 				val, _ := strconv.ParseBool(params.Get(key))
 				fields.Billable = &val
@@ -576,6 +631,7 @@ func projectUpdateRequest(apiKey string, endpoint string, options []RequestOptio
 			}
 		}
 	}
+	res.fields = withExtraFields(fields, res.params)
 	res.params.Del(nameKey)
 	res.params.Del(clientIDKey)
 	res.params.Del(isPublicKey)
@@ -584,7 +640,7 @@ func projectUpdateRequest(apiKey string, endpoint string, options []RequestOptio
 	res.params.Del(noteKey)
 	res.params.Del(billableKey)
 	res.params.Del(archivedKey)
-	res.fields = fields
+	res.params.Del(extraFieldsKey)
 
 	injectContext(&res, options)
 
@@ -592,28 +648,25 @@ func projectUpdateRequest(apiKey string, endpoint string, options []RequestOptio
 }
 
 // UpdateEstimate update existing Project's estimate based on fields and options given.
-func (p *ProjectNode) UpdateEstimate(workspaceID string, id string,
+func (p *ProjectNode) UpdateEstimate(workspaceID WorkspaceID, id ProjectID,
 	opts ...RequestOption) (*Project, error) {
 	endpoint := fmt.Sprintf("%s/workspaces/%s/projects/%s/estimate", p.endpoint,
 		workspaceID, id)
-	res, err := patch(projectUpdateEstimateRequest(p.apiKey, endpoint, opts))
+	res, err := p.requester.patch(projectUpdateEstimateRequest(endpoint, opts))
 	if err != nil {
 		return nil, fmt.Errorf("put: %w", err)
 	}
 	result := new(Project)
-	if err := json.Unmarshal(res, &result); err != nil {
-		if jErr, ok := err.(*json.UnmarshalTypeError); ok {
-			return nil, fmt.Errorf("unmarshal field %v of type %v", jErr.Field, jErr.Type)
-		}
-		return nil, fmt.Errorf("json unmarshal: %w", err)
+	if err := p.requester.unmarshal(res, &result); err != nil {
+		return nil, err
 	}
 	return result, nil
 }
 
-func projectUpdateEstimateRequest(apiKey string, endpoint string,
+func projectUpdateEstimateRequest(endpoint string,
 	options []RequestOption) requestOptions {
 	res := requestOptions{
-		apiKey:   apiKey,
+		resource: ResourceProject,
 		endpoint: endpoint,
 	}
 
@@ -642,28 +695,25 @@ func projectUpdateEstimateRequest(apiKey string, endpoint string,
 }
 
 // UpdateMemberships update existing Project's memberships based on fields given.
-func (p *ProjectNode) UpdateMemberships(workspaceID string, id string,
+func (p *ProjectNode) UpdateMemberships(workspaceID WorkspaceID, id ProjectID,
 	opts ...RequestOption) (*Project, error) {
 	endpoint := fmt.Sprintf("%s/workspaces/%s/projects/%s/memberships", p.endpoint,
 		workspaceID, id)
-	res, err := patch(projectUpdateMembershipRequest(p.apiKey, endpoint, opts))
+	res, err := p.requester.patch(projectUpdateMembershipRequest(endpoint, opts))
 	if err != nil {
 		return nil, fmt.Errorf("put: %w", err)
 	}
 	result := new(Project)
-	if err := json.Unmarshal(res, &result); err != nil {
-		if jErr, ok := err.(*json.UnmarshalTypeError); ok {
-			return nil, fmt.Errorf("unmarshal field %v of type %v", jErr.Field, jErr.Type)
-		}
-		return nil, fmt.Errorf("json unmarshal: %w", err)
+	if err := p.requester.unmarshal(res, &result); err != nil {
+		return nil, err
 	}
 	return result, nil
 }
 
-func projectUpdateMembershipRequest(apiKey string, endpoint string,
+func projectUpdateMembershipRequest(endpoint string,
 	options []RequestOption) requestOptions {
 	res := requestOptions{
-		apiKey:   apiKey,
+		resource: ResourceProject,
 		endpoint: endpoint,
 	}
 
@@ -674,9 +724,12 @@ func projectUpdateMembershipRequest(apiKey string, endpoint string,
 			key := opt.paramsProvider(params)
 			switch key {
 			case membershipsKey:
-				m := &Memberships{}
-				_ = json.Unmarshal([]byte(params.Get(key)), m)
-				fields.Memberships = m
+				var m []Memberships
+				_ = json.Unmarshal([]byte(params.Get(key)), &m)
+				if fields.Memberships == nil {
+					fields.Memberships = &[]Memberships{}
+				}
+				*fields.Memberships = append(*fields.Memberships, m...)
 			}
 		}
 	}
@@ -688,28 +741,25 @@ func projectUpdateMembershipRequest(apiKey string, endpoint string,
 }
 
 // UpdateTemplate update existing Project's template based on fields options given.
-func (p *ProjectNode) UpdateTemplate(workspaceID string, id string,
+func (p *ProjectNode) UpdateTemplate(workspaceID WorkspaceID, id ProjectID,
 	opts ...RequestOption) (*Project, error) {
 	endpoint := fmt.Sprintf("%s/workspaces/%s/projects/%s/template", p.endpoint,
 		workspaceID, id)
-	res, err := patch(projectUpdateTemplateRequest(p.apiKey, endpoint, opts))
+	res, err := p.requester.patch(projectUpdateTemplateRequest(endpoint, opts))
 	if err != nil {
 		return nil, fmt.Errorf("put: %w", err)
 	}
 	result := new(Project)
-	if err := json.Unmarshal(res, &result); err != nil {
-		if jErr, ok := err.(*json.UnmarshalTypeError); ok {
-			return nil, fmt.Errorf("unmarshal field %v of type %v", jErr.Field, jErr.Type)
-		}
-		return nil, fmt.Errorf("json unmarshal: %w", err)
+	if err := p.requester.unmarshal(res, &result); err != nil {
+		return nil, err
 	}
 	return result, nil
 }
 
-func projectUpdateTemplateRequest(apiKey string, endpoint string,
+func projectUpdateTemplateRequest(endpoint string,
 	options []RequestOption) requestOptions {
 	res := requestOptions{
-		apiKey:   apiKey,
+		resource: ResourceProject,
 		endpoint: endpoint,
 	}
 
@@ -732,27 +782,28 @@ func projectUpdateTemplateRequest(apiKey string, endpoint string,
 	return res
 }
 
-// Delete existing Project.
-func (p *ProjectNode) Delete(workspaceID string, id string, opts ...RequestOption) (*Project,
-	error) {
+// Delete existing Project, and return the deleted Project. Nil Project is returned when
+// Clockify respond without body.
+func (p *ProjectNode) Delete(workspaceID WorkspaceID, id ProjectID,
+	opts ...RequestOption) (*Project, error) {
 	endpoint := fmt.Sprintf("%s/workspaces/%s/projects/%s", p.endpoint, workspaceID, id)
-	res, err := del(projectDeleteRequest(p.apiKey, endpoint, opts))
+	res, err := p.requester.del(projectDeleteRequest(endpoint, opts))
 	if err != nil {
 		return nil, fmt.Errorf("del: %w", err)
 	}
+	if emptyBody(res) {
+		return nil, nil
+	}
 	result := new(Project)
-	if err := json.Unmarshal(res, &result); err != nil {
-		if jErr, ok := err.(*json.UnmarshalTypeError); ok {
-			return nil, fmt.Errorf("unmarshal field %v of type %v", jErr.Field, jErr.Type)
-		}
-		return nil, fmt.Errorf("json unmarshal: %w", err)
+	if err := p.requester.unmarshal(res, &result); err != nil {
+		return nil, err
 	}
 	return result, nil
 }
 
-func projectDeleteRequest(apiKey string, endpoint string, options []RequestOption) requestOptions {
+func projectDeleteRequest(endpoint string, options []RequestOption) requestOptions {
 	res := requestOptions{
-		apiKey:   apiKey,
+		resource: ResourceProject,
 		endpoint: endpoint,
 	}
 	injectContext(&res, options)
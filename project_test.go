@@ -0,0 +1,159 @@
+package glockify
+
+import (
+	"fmt"
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/suite"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+)
+
+type ProjectTestSuite struct {
+	suite.Suite
+	server    projectMockServer
+	testIndex int
+}
+
+type projectMockServer struct {
+	baseServer *httptest.Server
+}
+
+func (s *ProjectTestSuite) SetupTest() {
+	testMux := mux.NewRouter()
+
+	testMux.HandleFunc("/workspaces/{workspaceID}/projects/{projectID}",
+		s.update()).Methods("PUT")
+
+	testMux.HandleFunc("/workspaces/{workspaceID}/projects/{projectID}",
+		s.delete()).Methods("DELETE")
+
+	s.server = projectMockServer{
+		baseServer: newJSONServer(testMux),
+	}
+}
+
+func (s *ProjectTestSuite) TearDownTest() {
+	s.server.baseServer.Close()
+}
+
+var testsProjectUpdate = []struct {
+	name        string
+	workspaceID string
+	projectID   string
+	options     []RequestOption
+	wantParams  url.Values
+	wantBody    string
+}{
+	{
+		name:        "No Options",
+		workspaceID: "Workspace1",
+		projectID:   "1",
+		wantParams:  map[string][]string{},
+		wantBody:    `{}`,
+	},
+	{
+		name:        "Set options",
+		workspaceID: "Workspace1",
+		projectID:   "2",
+		options: []RequestOption{
+			WithName("Dummy Name"),
+			WithBillable(false),
+			WithColor("#ffffff"),
+		},
+		wantParams: map[string][]string{},
+		wantBody:   `{"name":"Dummy Name","billable":false,"color":"#ffffff"}`,
+	},
+	{
+		name:        "Clear fields",
+		workspaceID: "Workspace1",
+		projectID:   "3",
+		options: []RequestOption{
+			WithNote(""),
+			WithClientID(""),
+		},
+		wantParams: map[string][]string{},
+		wantBody:   `{"note":"","clientId":""}`,
+	},
+}
+
+func (s *ProjectTestSuite) update() func(http.ResponseWriter, *http.Request) {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if !checkAuthHeader(r) {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		s.Require().Equal("PUT", r.Method)
+
+		test := testsProjectUpdate[s.testIndex]
+
+		path := mux.Vars(r)
+		workspaceID, ok := path["workspaceID"]
+		s.Require().True(ok)
+		s.Require().Equal(test.workspaceID, workspaceID)
+		projectID, ok := path["projectID"]
+		s.Require().True(ok)
+		s.Require().Equal(test.projectID, projectID)
+
+		s.Require().Equal(test.wantParams, r.URL.Query())
+
+		body, err := ioutil.ReadAll(r.Body)
+		s.Require().Nil(err)
+		s.Require().JSONEq(test.wantBody, string(body))
+
+		w.WriteHeader(http.StatusOK)
+		_, err = fmt.Fprintf(w, `{"id":"dummy"}`)
+		s.Require().Nil(err)
+	}
+}
+
+func (s *ProjectTestSuite) TestUpdate() {
+	glock := New(dummyAPIKey, WithEndpoint(Endpoint{
+		Base: s.server.baseServer.URL,
+	}))
+
+	for index, tc := range testsProjectUpdate {
+		s.Run(tc.name, func() {
+			s.testIndex = index
+			_, err := glock.Project.Update(WorkspaceID(tc.workspaceID), ProjectID(tc.projectID),
+				tc.options...)
+			s.Require().Nil(err)
+		})
+	}
+}
+
+// delete respond with deleted Project for Project1, and without body otherwise.
+func (s *ProjectTestSuite) delete() func(http.ResponseWriter, *http.Request) {
+	return func(w http.ResponseWriter, r *http.Request) {
+		s.Require().True(checkAuthHeader(r))
+		id := mux.Vars(r)["projectID"]
+		if id != "Project1" {
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+		_, err := fmt.Fprintf(w, `{"id":%q}`, id)
+		s.Require().Nil(err)
+	}
+}
+
+func (s *ProjectTestSuite) TestDelete() {
+	glock := New(dummyAPIKey, WithEndpoint(Endpoint{
+		Base: s.server.baseServer.URL,
+	}))
+
+	for _, id := range []string{"Project1", "Project2"} {
+		project, err := glock.Project.Delete("Workspace1", ProjectID(id))
+		s.Require().Nil(err)
+		if id == "Project1" {
+			s.Require().Equal(ProjectID(id), project.ID)
+		} else {
+			s.Require().Nil(project)
+		}
+	}
+}
+
+func TestProjectNode(t *testing.T) {
+	suite.Run(t, &ProjectTestSuite{})
+}
@@ -0,0 +1,596 @@
+package glockify
+
+import (
+	"fmt"
+	"net/url"
+	"strconv"
+	"time"
+)
+
+// ReportNode generating reports from Report endpoint.
+type ReportNode struct {
+	endpoint  string
+	requester *requester
+}
+
+// ReportGroup is criteria which time entries of report grouped by.
+type ReportGroup string
+
+// Possible values of ReportGroup
+const (
+	ReportGroupProject   ReportGroup = "PROJECT"
+	ReportGroupClient    ReportGroup = "CLIENT"
+	ReportGroupUser      ReportGroup = "USER"
+	ReportGroupUserGroup ReportGroup = "USER_GROUP"
+	ReportGroupTask      ReportGroup = "TASK"
+	ReportGroupTag       ReportGroup = "TAG"
+	ReportGroupDate      ReportGroup = "DATE"
+	ReportGroupWeek      ReportGroup = "WEEK"
+	ReportGroupMonth     ReportGroup = "MONTH"
+	ReportGroupTimeEntry ReportGroup = "TIMEENTRY"
+)
+
+// AmountShown is kind of amount calculated by report.
+type AmountShown string
+
+// Possible values of AmountShown
+const (
+	AmountShownEarned AmountShown = "EARNED"
+	AmountShownCost   AmountShown = "COST"
+	AmountShownProfit AmountShown = "PROFIT"
+	AmountShownHide   AmountShown = "HIDE_AMOUNT"
+)
+
+// SummarySortColumn is column which groups of summary report sorted by.
+type SummarySortColumn string
+
+// Possible values of SummarySortColumn
+const (
+	SummarySortColumnGroup    SummarySortColumn = "GROUP"
+	SummarySortColumnDuration SummarySortColumn = "DURATION"
+	SummarySortColumnAmount   SummarySortColumn = "AMOUNT"
+)
+
+// ReportAmount is amount of one AmountShown kind, along with its value per
+// currency used in workspace.
+type ReportAmount struct {
+	Type               AmountShown `json:"type,omitempty"`
+	Value              Money       `json:"value"`
+	AmountByCurrencies []Money     `json:"amountByCurrencies,omitempty"`
+}
+
+// ReportTotal is totals of every time entry in report.
+type ReportTotal struct {
+	TotalTime         Duration       `json:"totalTime"`
+	TotalBillableTime Duration       `json:"totalBillableTime"`
+	EntriesCount      int            `json:"entriesCount"`
+	TotalAmount       Money          `json:"totalAmount"`
+	Amounts           []ReportAmount `json:"amounts,omitempty"`
+}
+
+// SummaryGroup is one group of SummaryReport. ID and Name is of resource
+// grouped by, eg: project's for ReportGroupProject. Children is groups of next
+// ReportGroup within this group.
+type SummaryGroup struct {
+	ID         string         `json:"_id"`
+	Name       string         `json:"name"`
+	Duration   Duration       `json:"duration"`
+	Amount     Money          `json:"amount"`
+	Amounts    []ReportAmount `json:"amounts,omitempty"`
+	ClientName string         `json:"clientName,omitempty"`
+	Color      string         `json:"color,omitempty"`
+	Children   []SummaryGroup `json:"children,omitempty"`
+}
+
+// SummaryReport is result of ReportNode.Summary. Totals is usually single item,
+// and empty when no time entry matched.
+// See: https://clockify.me/developers-api#tag-Reports
+type SummaryReport struct {
+	Totals   []ReportTotal  `json:"totals"`
+	GroupOne []SummaryGroup `json:"groupOne"`
+}
+
+const (
+	projectsKey           = "projects"
+	containsProjectKey    = "contains-project"
+	amountShownKey        = "amountShown"
+	tasksKey              = "tasks"
+	containsTaskKey       = "contains-task"
+	containsTagKey        = "contains-tag"
+	userGroupsKey         = "user-groups"
+	withoutDescriptionKey = "without-description"
+	roundingKey           = "rounding"
+)
+
+// WithProjects if set, report will be filtered by project IDs.
+// Filter behaviour depends on the WithContainsProject.
+func WithProjects(ids []ProjectID) RequestOption {
+	return RequestOption{
+		paramsProvider: func(v url.Values) string {
+			for _, id := range ids {
+				v.Add(projectsKey, string(id))
+			}
+			return projectsKey
+		},
+	}
+}
+
+// WithContainsProject if set to true, WithProjects filter will be inclusion,
+// otherwise it will be exclusion. Default to true.
+func WithContainsProject(containsProject bool) RequestOption {
+	return RequestOption{
+		paramsProvider: func(v url.Values) string {
+			v.Set(containsProjectKey, strconv.FormatBool(containsProject))
+			return containsProjectKey
+		},
+	}
+}
+
+// WithTasks if set, report will be filtered by task IDs.
+// Filter behaviour depends on the WithContainsTask.
+func WithTasks(ids []TaskID) RequestOption {
+	return RequestOption{
+		paramsProvider: func(v url.Values) string {
+			for _, id := range ids {
+				v.Add(tasksKey, string(id))
+			}
+			return tasksKey
+		},
+	}
+}
+
+// WithContainsTask if set to true, WithTasks filter will be inclusion,
+// otherwise it will be exclusion. Default to true.
+func WithContainsTask(containsTask bool) RequestOption {
+	return RequestOption{
+		paramsProvider: func(v url.Values) string {
+			v.Set(containsTaskKey, strconv.FormatBool(containsTask))
+			return containsTaskKey
+		},
+	}
+}
+
+// WithContainsTag if set to true, WithTagIDs filter of report will be
+// inclusion, otherwise it will be exclusion. Default to true.
+func WithContainsTag(containsTag bool) RequestOption {
+	return RequestOption{
+		paramsProvider: func(v url.Values) string {
+			v.Set(containsTagKey, strconv.FormatBool(containsTag))
+			return containsTagKey
+		},
+	}
+}
+
+// WithUserGroups if set, report will be filtered by user group IDs.
+func WithUserGroups(ids []UserGroupID) RequestOption {
+	return RequestOption{
+		paramsProvider: func(v url.Values) string {
+			for _, id := range ids {
+				v.Add(userGroupsKey, string(id))
+			}
+			return userGroupsKey
+		},
+	}
+}
+
+// WithoutDescription if set to true, report only contains time entries
+// without description. Default to false.
+func WithoutDescription(without bool) RequestOption {
+	return RequestOption{
+		paramsProvider: func(v url.Values) string {
+			v.Set(withoutDescriptionKey, strconv.FormatBool(without))
+			return withoutDescriptionKey
+		},
+	}
+}
+
+// WithRounding if set to true, durations of report are rounded based on
+// workspace's rounding settings. Default to false.
+func WithRounding(rounding bool) RequestOption {
+	return RequestOption{
+		paramsProvider: func(v url.Values) string {
+			v.Set(roundingKey, strconv.FormatBool(rounding))
+			return roundingKey
+		},
+	}
+}
+
+// WithAmountShown set kind of amount calculated by report. Default to
+// AmountShownEarned.
+func WithAmountShown(amountShown AmountShown) RequestOption {
+	return RequestOption{
+		paramsProvider: func(v url.Values) string {
+			v.Set(amountShownKey, string(amountShown))
+			return amountShownKey
+		},
+	}
+}
+
+// WithSummarySortColumn set column which summary report groups sorted by.
+func WithSummarySortColumn(sortColumn SummarySortColumn) RequestOption {
+	return RequestOption{
+		paramsProvider: func(v url.Values) string {
+			v.Set(sortColumnKey, string(sortColumn))
+			return sortColumnKey
+		},
+	}
+}
+
+type reportFilter struct {
+	IDs      []string `json:"ids,omitempty"`
+	Contains string   `json:"contains,omitempty"`
+	Status   string   `json:"status,omitempty"`
+}
+
+type reportFields struct {
+	DateRangeStart     string         `json:"dateRangeStart"`
+	DateRangeEnd       string         `json:"dateRangeEnd"`
+	SortOrder          SortOrderValue `json:"sortOrder,omitempty"`
+	Billable           *bool          `json:"billable,omitempty"`
+	Description        string         `json:"description,omitempty"`
+	WithoutDescription bool           `json:"withoutDescription,omitempty"`
+	Rounding           bool           `json:"rounding,omitempty"`
+	AmountShown        AmountShown    `json:"amountShown,omitempty"`
+	Clients            *reportFilter  `json:"clients,omitempty"`
+	Projects           *reportFilter  `json:"projects,omitempty"`
+	Tasks              *reportFilter  `json:"tasks,omitempty"`
+	Tags               *reportFilter  `json:"tags,omitempty"`
+	Users              *reportFilter  `json:"users,omitempty"`
+	UserGroups         *reportFilter  `json:"userGroups,omitempty"`
+}
+
+type summaryFilterFields struct {
+	Groups     []ReportGroup     `json:"groups"`
+	SortColumn SummarySortColumn `json:"sortColumn,omitempty"`
+}
+
+type summaryReportFields struct {
+	reportFields
+	SummaryFilter summaryFilterFields `json:"summaryFilter"`
+}
+
+// Summary generate summary report of time entries between start and end,
+// grouped by up to three groups, eg: ReportGroupProject then ReportGroupUser.
+// Empty groups default to ReportGroupProject then ReportGroupTimeEntry.
+// Filter with WithClients, WithProjects, WithTasks, WithTagIDs, WithUsers,
+// WithUserGroups, WithBillable and WithDescription, along with their
+// WithContainsXxx and status options.
+func (r *ReportNode) Summary(workspaceID WorkspaceID, start time.Time, end time.Time,
+	groups []ReportGroup, opts ...RequestOption) (*SummaryReport, error) {
+	endpoint := fmt.Sprintf("%s/workspaces/%s/reports/summary", r.endpoint, workspaceID)
+	res, err := r.requester.post(reportSummaryRequest(endpoint, start, end, groups, opts))
+	if err != nil {
+		return nil, fmt.Errorf("post: %w", err)
+	}
+	result := new(SummaryReport)
+	if err := r.requester.unmarshal(res, &result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func reportSummaryRequest(endpoint string, start time.Time, end time.Time,
+	groups []ReportGroup, options []RequestOption) requestOptions {
+	res := reportRequest(endpoint, options)
+	if len(groups) == 0 {
+		groups = []ReportGroup{ReportGroupProject, ReportGroupTimeEntry}
+	}
+	res.fields = summaryReportFields{
+		reportFields: newReportFields(start, end, res.params),
+		SummaryFilter: summaryFilterFields{
+			Groups:     groups,
+			SortColumn: SummarySortColumn(res.params.Get(sortColumnKey)),
+		},
+	}
+	res.params = nil
+
+	return res
+}
+
+// reportRequest collect params of options given, which report builders turn
+// into request body.
+func reportRequest(endpoint string, options []RequestOption) requestOptions {
+	res := requestOptions{
+		resource:  ResourceReport,
+		operation: OperationReport,
+		endpoint:  endpoint,
+	}
+	res.params = url.Values{}
+	for _, opt := range options {
+		if opt.paramsProvider != nil {
+			opt.paramsProvider(res.params)
+		}
+	}
+	injectContext(&res, options)
+
+	return res
+}
+
+func newReportFields(start time.Time, end time.Time, params url.Values) reportFields {
+	fields := reportFields{
+		DateRangeStart: formatTime(start),
+		DateRangeEnd:   formatTime(end),
+		SortOrder:      SortOrderValue(params.Get(sortOrderKey)),
+		Description:    params.Get(descriptionKey),
+		AmountShown:    AmountShown(params.Get(amountShownKey)),
+		Clients: newReportFilter(params[clientsKey], params.Get(containsClientKey),
+			params.Get(clientStatusKey)),
+		Projects: newReportFilter(params[projectsKey], params.Get(containsProjectKey), ""),
+		Tasks:    newReportFilter(params[tasksKey], params.Get(containsTaskKey), ""),
+		Tags:     newReportFilter(params[tagsKey], params.Get(containsTagKey), ""),
+		Users: newReportFilter(params[usersKey], params.Get(containsUserKey),
+			params.Get(userStatusKey)),
+		UserGroups: newReportFilter(params[userGroupsKey], "", ""),
+	}
+	if billable, err := strconv.ParseBool(params.Get(billableKey)); err == nil {
+		fields.Billable = &billable
+	}
+	fields.WithoutDescription, _ = strconv.ParseBool(params.Get(withoutDescriptionKey))
+	fields.Rounding, _ = strconv.ParseBool(params.Get(roundingKey))
+	return fields
+}
+
+// newReportFilter return nil when neither ids nor status given, so the filter
+// is left out of request.
+func newReportFilter(ids []string, contains string, status string) *reportFilter {
+	if len(ids) == 0 && status == "" {
+		return nil
+	}
+	filter := &reportFilter{IDs: ids, Status: status}
+	if len(ids) > 0 {
+		filter.Contains = "CONTAINS"
+		if c, err := strconv.ParseBool(contains); err == nil && !c {
+			filter.Contains = "DOES_NOT_CONTAIN"
+		}
+	}
+	return filter
+}
+
+// DetailedSortColumn is column which entries of detailed report sorted by.
+type DetailedSortColumn string
+
+// Possible values of DetailedSortColumn
+const (
+	DetailedSortColumnDate        DetailedSortColumn = "DATE"
+	DetailedSortColumnUser        DetailedSortColumn = "USER"
+	DetailedSortColumnDuration    DetailedSortColumn = "DURATION"
+	DetailedSortColumnDescription DetailedSortColumn = "DESCRIPTION"
+	DetailedSortColumnAmount      DetailedSortColumn = "AMOUNT"
+)
+
+// WithDetailedSortColumn set column which detailed report entries sorted by.
+// Default to DetailedSortColumnDate.
+func WithDetailedSortColumn(sortColumn DetailedSortColumn) RequestOption {
+	return RequestOption{
+		paramsProvider: func(v url.Values) string {
+			v.Set(sortColumnKey, string(sortColumn))
+			return sortColumnKey
+		},
+	}
+}
+
+// DetailedReportEntry is one time entry of DetailedReport, along with names of
+// its user, project, client and task.
+type DetailedReportEntry struct {
+	ID                TimeEntryID  `json:"_id"`
+	Description       string       `json:"description,omitempty"`
+	UserID            UserID       `json:"userId,omitempty"`
+	UserName          string       `json:"userName,omitempty"`
+	UserEmail         string       `json:"userEmail,omitempty"`
+	Billable          bool         `json:"billable,omitempty"`
+	ProjectID         ProjectID    `json:"projectId,omitempty"`
+	ProjectName       string       `json:"projectName,omitempty"`
+	ProjectColor      string       `json:"projectColor,omitempty"`
+	ClientID          ClientID     `json:"clientId,omitempty"`
+	ClientName        string       `json:"clientName,omitempty"`
+	TaskID            TaskID       `json:"taskId,omitempty"`
+	TaskName          string       `json:"taskName,omitempty"`
+	TagIds            []TagID      `json:"tagIds,omitempty"`
+	Tags              []Tag        `json:"tags,omitempty"`
+	TimeInterval      TimeInterval `json:"timeInterval"`
+	Rate              Money        `json:"rate"`
+	Amount            Money        `json:"amount"`
+	IsLocked          bool         `json:"isLocked,omitempty"`
+	ApprovalRequestID string       `json:"approvalRequestId,omitempty"`
+}
+
+// DetailedReport is one page of ReportNode.Detailed. Totals is empty when no
+// time entry matched, or when the page isn't the first one of iterator.
+// See: https://clockify.me/developers-api#tag-Reports
+type DetailedReport struct {
+	Totals      []ReportTotal         `json:"totals"`
+	TimeEntries []DetailedReportEntry `json:"timeentries"`
+}
+
+const (
+	reportTotalsCalculate = "CALCULATE"
+	reportTotalsExclude   = "EXCLUDE"
+)
+
+// maxDetailedPageSize is the largest page size Clockify accepts for detailed report.
+const maxDetailedPageSize = 1000
+
+type detailedFilterOptionsFields struct {
+	Totals string `json:"totals"`
+}
+
+type detailedFilterFields struct {
+	Page       int                         `json:"page"`
+	PageSize   int                         `json:"pageSize"`
+	SortColumn DetailedSortColumn          `json:"sortColumn,omitempty"`
+	Options    detailedFilterOptionsFields `json:"options"`
+}
+
+type detailedReportFields struct {
+	reportFields
+	DetailedFilter detailedFilterFields `json:"detailedFilter"`
+}
+
+// Detailed get one page of detailed report of time entries between start and
+// end, set by WithPage and WithPageSize. Page size over 1000 is clamped to 1000,
+// the maximum accepted by Clockify. It accepts
+// every filter of Summary, see: DetailedIterator to get every entry.
+func (r *ReportNode) Detailed(workspaceID WorkspaceID, start time.Time, end time.Time,
+	opts ...RequestOption) (*DetailedReport, error) {
+	return r.detailed(workspaceID, start, end, reportTotalsCalculate, opts)
+}
+
+func (r *ReportNode) detailed(workspaceID WorkspaceID, start time.Time, end time.Time,
+	totals string, opts []RequestOption) (*DetailedReport, error) {
+	endpoint := fmt.Sprintf("%s/workspaces/%s/reports/detailed", r.endpoint, workspaceID)
+	res, err := r.requester.post(reportDetailedRequest(endpoint, start, end, totals, opts))
+	if err != nil {
+		return nil, fmt.Errorf("post: %w", err)
+	}
+	result := new(DetailedReport)
+	if err := r.requester.unmarshal(res, &result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func reportDetailedRequest(endpoint string, start time.Time, end time.Time, totals string,
+	options []RequestOption) requestOptions {
+	res := reportRequest(endpoint, options)
+	filter := detailedFilterFields{
+		Page:       defaultPage,
+		PageSize:   defaultPageSize,
+		SortColumn: DetailedSortColumn(res.params.Get(sortColumnKey)),
+		Options:    detailedFilterOptionsFields{Totals: totals},
+	}
+	if page, err := strconv.Atoi(res.params.Get(pageKey)); err == nil {
+		filter.Page = page
+	}
+	if pageSize, err := strconv.Atoi(res.params.Get(pageSizeKey)); err == nil && pageSize > 0 {
+		filter.PageSize = clampDetailedPageSize(pageSize)
+	}
+	res.fields = detailedReportFields{
+		reportFields:   newReportFields(start, end, res.params),
+		DetailedFilter: filter,
+	}
+	res.params = nil
+
+	return res
+}
+
+func clampDetailedPageSize(pageSize int) int {
+	if pageSize > maxDetailedPageSize {
+		return maxDetailedPageSize
+	}
+	return pageSize
+}
+
+// DetailedReportIterator stream entries of detailed report page by page, only
+// keeping current page in memory. Use it as:
+//
+//	it := g.Report.DetailedIterator(workspaceID, start, end)
+//	for it.Next() {
+//		fmt.Println(it.Entry().Description)
+//	}
+//	if err := it.Err(); err != nil {
+//		return err
+//	}
+type DetailedReportIterator struct {
+	node        *ReportNode
+	workspaceID WorkspaceID
+	start       time.Time
+	end         time.Time
+	opts        []RequestOption
+
+	page     int
+	pageSize int
+	totals   *ReportTotal
+	entries  []DetailedReportEntry
+	index    int
+	fetched  int
+	last     bool
+	err      error
+}
+
+// DetailedIterator return iterator over every entry of detailed report between
+// start and end, requesting next page until totals are exhausted. Page size is
+// set by WithPageSize, clamped to 1000 like Detailed, while WithPage is ignored.
+func (r *ReportNode) DetailedIterator(workspaceID WorkspaceID, start time.Time, end time.Time,
+	opts ...RequestOption) *DetailedReportIterator {
+	pageSize := defaultPageSize
+	params := url.Values{}
+	for _, opt := range opts {
+		if opt.paramsProvider != nil {
+			opt.paramsProvider(params)
+		}
+	}
+	if size, err := strconv.Atoi(params.Get(pageSizeKey)); err == nil && size > 0 {
+		pageSize = clampDetailedPageSize(size)
+	}
+	return &DetailedReportIterator{
+		node:        r,
+		workspaceID: workspaceID,
+		start:       start,
+		end:         end,
+		opts:        opts,
+		page:        defaultPage - 1,
+		pageSize:    pageSize,
+		index:       -1,
+	}
+}
+
+// Next advance iterator to next entry, fetching next page when needed. It
+// returns false when every entry has been iterated or an error occurred.
+func (d *DetailedReportIterator) Next() bool {
+	if d.err != nil {
+		return false
+	}
+	d.index++
+	if d.index < len(d.entries) {
+		return true
+	}
+	if d.last {
+		return false
+	}
+
+	d.page++
+	totals := reportTotalsExclude
+	if d.page == defaultPage {
+		totals = reportTotalsCalculate
+	}
+	pageOpts := append(d.opts[:len(d.opts):len(d.opts)], WithPage(d.page),
+		WithPageSize(d.pageSize))
+	report, err := d.node.detailed(d.workspaceID, d.start, d.end, totals, pageOpts)
+	if err != nil {
+		d.err = fmt.Errorf("page %d: %w", d.page, err)
+		d.entries = nil
+		return false
+	}
+	if d.totals == nil {
+		d.totals = &ReportTotal{}
+		if len(report.Totals) > 0 {
+			d.totals = &report.Totals[0]
+		}
+	}
+
+	d.entries = report.TimeEntries
+	d.index = 0
+	d.fetched += len(d.entries)
+	d.last = len(d.entries) < d.pageSize || d.fetched >= d.totals.EntriesCount
+	return len(d.entries) > 0
+}
+
+// Entry return current entry. It's only valid after Next returns true, zero
+// value is returned before first call of Next or after Next returns false.
+func (d *DetailedReportIterator) Entry() DetailedReportEntry {
+	if d.index < 0 || d.index >= len(d.entries) {
+		return DetailedReportEntry{}
+	}
+	return d.entries[d.index]
+}
+
+// Totals return totals of the whole report, fetched along with first page.
+// It's nil before first call of Next.
+func (d *DetailedReportIterator) Totals() *ReportTotal {
+	return d.totals
+}
+
+// Err return error stopping the iteration, if any.
+func (d *DetailedReportIterator) Err() error {
+	return d.err
+}
@@ -0,0 +1,246 @@
+package glockify
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/suite"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+type ReportTestSuite struct {
+	suite.Suite
+	server    reportMockServer
+	testIndex int
+	requests  []detailedReportFields
+	failPage  int
+}
+
+type reportMockServer struct {
+	reportServer *httptest.Server
+}
+
+func (s *ReportTestSuite) SetupTest() {
+	s.requests = nil
+	s.failPage = 0
+
+	testMux := mux.NewRouter()
+
+	testMux.HandleFunc("/workspaces/{workspaceID}/reports/summary", s.summary()).
+		Methods("POST")
+	testMux.HandleFunc("/workspaces/{workspaceID}/reports/detailed", s.detailed()).
+		Methods("POST")
+
+	s.server = reportMockServer{
+		reportServer: newJSONServer(testMux),
+	}
+}
+
+func (s *ReportTestSuite) TearDownTest() {
+	s.server.reportServer.Close()
+}
+
+var testsReportSummary = []struct {
+	name     string
+	groups   []ReportGroup
+	options  []RequestOption
+	wantBody string
+}{
+	{
+		name: "Default Groups",
+		wantBody: `{"dateRangeStart":"2021-01-01T00:00:00Z","dateRangeEnd":"2021-01-31T23:59:59Z",
+"summaryFilter":{"groups":["PROJECT","TIMEENTRY"]}}`,
+	},
+	{
+		name:   "Set Filters",
+		groups: []ReportGroup{ReportGroupClient, ReportGroupUser},
+		options: []RequestOption{
+			WithClients([]ClientID{"Client1", "Client2"}),
+			WithProjects([]ProjectID{"Project1"}),
+			WithContainsProject(false),
+			WithUserStatus(UserStatusActive),
+			WithBillable(false),
+			WithAmountShown(AmountShownCost),
+			WithSortOrder(SortOrderAscending),
+			WithSummarySortColumn(SummarySortColumnDuration),
+		},
+		wantBody: `{"dateRangeStart":"2021-01-01T00:00:00Z","dateRangeEnd":"2021-01-31T23:59:59Z",
+"sortOrder":"ASCENDING","billable":false,"amountShown":"COST",
+"clients":{"ids":["Client1","Client2"],"contains":"CONTAINS"},
+"projects":{"ids":["Project1"],"contains":"DOES_NOT_CONTAIN"},
+"users":{"status":"ACTIVE"},
+"summaryFilter":{"groups":["CLIENT","USER"],"sortColumn":"DURATION"}}`,
+	},
+}
+
+func (s *ReportTestSuite) summary() func(http.ResponseWriter, *http.Request) {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if !checkAuthHeader(r) {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		test := testsReportSummary[s.testIndex]
+		s.Require().Equal("Workspace1", mux.Vars(r)["workspaceID"])
+		s.Require().Empty(r.URL.Query())
+		body, err := ioutil.ReadAll(r.Body)
+		s.Require().Nil(err)
+		s.Require().JSONEq(test.wantBody, string(body))
+
+		_, err = fmt.Fprintf(w, `{"totals":[{"_id":"","totalTime":5400,"totalBillableTime":3600,
+"entriesCount":2,"totalAmount":15000,"amounts":[{"type":"EARNED","value":15000,
+"amountByCurrencies":[{"currency":"USD","amount":15000}]}]}],
+"groupOne":[{"_id":"Project1","name":"Project","duration":5400,"amount":15000,
+"children":[{"_id":"TimeEntry1","name":"Entry","duration":3600,"amount":10000}]}]}`)
+		s.Require().Nil(err)
+	}
+}
+
+func (s *ReportTestSuite) TestSummary() {
+	glock := New(dummyAPIKey, WithEndpoint(Endpoint{
+		Report: s.server.reportServer.URL,
+	}))
+	start := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+	end := time.Date(2021, 1, 31, 23, 59, 59, 0, time.UTC)
+	for index, tc := range testsReportSummary {
+		s.Run(tc.name, func() {
+			s.testIndex = index
+			report, err := glock.Report.Summary("Workspace1", start, end, tc.groups,
+				tc.options...)
+			s.Require().Nil(err)
+			s.Require().Len(report.Totals, 1)
+			s.Require().Equal(Duration(90*time.Minute), report.Totals[0].TotalTime)
+			s.Require().Equal(2, report.Totals[0].EntriesCount)
+			s.Require().Equal(NewMoney(15000, "USD"),
+				report.Totals[0].Amounts[0].AmountByCurrencies[0])
+			s.Require().Equal("Project", report.GroupOne[0].Name)
+			s.Require().Equal(int64(10000), report.GroupOne[0].Children[0].Amount.Amount)
+		})
+	}
+}
+
+// reportDetailedEntries is every entry of mocked detailed report.
+const reportDetailedEntries = 5
+
+func (s *ReportTestSuite) detailed() func(http.ResponseWriter, *http.Request) {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if !checkAuthHeader(r) {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		fields := detailedReportFields{}
+		s.Require().Nil(json.NewDecoder(r.Body).Decode(&fields))
+		s.requests = append(s.requests, fields)
+		filter := fields.DetailedFilter
+		if filter.Page == s.failPage {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		report := DetailedReport{}
+		if filter.Options.Totals == reportTotalsCalculate {
+			report.Totals = []ReportTotal{{EntriesCount: reportDetailedEntries}}
+		}
+		for i := (filter.Page - 1) * filter.PageSize; i < filter.Page*filter.PageSize &&
+			i < reportDetailedEntries; i++ {
+			report.TimeEntries = append(report.TimeEntries, DetailedReportEntry{
+				ID: TimeEntryID(fmt.Sprintf("TimeEntry%d", i+1)),
+			})
+		}
+		s.Require().Nil(json.NewEncoder(w).Encode(report))
+	}
+}
+
+func (s *ReportTestSuite) glock() *Glockify {
+	return New(dummyAPIKey, WithEndpoint(Endpoint{
+		Report: s.server.reportServer.URL,
+	}))
+}
+
+func (s *ReportTestSuite) TestDetailed() {
+	start := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+	end := time.Date(2021, 1, 31, 23, 59, 59, 0, time.UTC)
+	report, err := s.glock().Report.Detailed("Workspace1", start, end, WithPage(2),
+		WithPageSize(2), WithTasks([]TaskID{"Task1"}), WithUserGroups([]UserGroupID{"Group1"}),
+		WithDescription("Meeting"), WithRounding(true),
+		WithDetailedSortColumn(DetailedSortColumnDuration))
+	s.Require().Nil(err)
+	s.Require().Len(report.TimeEntries, 2)
+	s.Require().Equal(TimeEntryID("TimeEntry3"), report.TimeEntries[0].ID)
+
+	request := s.requests[0]
+	s.Require().Equal(detailedFilterFields{Page: 2, PageSize: 2,
+		SortColumn: DetailedSortColumnDuration,
+		Options:    detailedFilterOptionsFields{Totals: reportTotalsCalculate}},
+		request.DetailedFilter)
+	s.Require().Equal(&reportFilter{IDs: []string{"Task1"}, Contains: "CONTAINS"},
+		request.Tasks)
+	s.Require().Equal(&reportFilter{IDs: []string{"Group1"}, Contains: "CONTAINS"},
+		request.UserGroups)
+	s.Require().Equal("Meeting", request.Description)
+	s.Require().True(request.Rounding)
+}
+
+func (s *ReportTestSuite) TestDetailedPageSize() {
+	start := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+	end := time.Date(2021, 1, 31, 23, 59, 59, 0, time.UTC)
+	report, err := s.glock().Report.Detailed("Workspace1", start, end, WithPageSize(5000))
+	s.Require().Nil(err)
+	s.Require().Len(report.TimeEntries, reportDetailedEntries)
+	s.Require().Equal(maxDetailedPageSize, s.requests[0].DetailedFilter.PageSize)
+
+	it := s.glock().Report.DetailedIterator("Workspace1", start, end, WithPageSize(5000))
+	count := 0
+	for it.Next() {
+		count++
+	}
+	s.Require().Nil(it.Err())
+	s.Require().Equal(reportDetailedEntries, count)
+	s.Require().Len(s.requests, 2)
+	s.Require().Equal(maxDetailedPageSize, s.requests[1].DetailedFilter.PageSize)
+}
+
+func (s *ReportTestSuite) TestDetailedIterator() {
+	it := s.glock().Report.DetailedIterator("Workspace1", time.Now().Add(-time.Hour),
+		time.Now(), WithPageSize(2), WithPage(5))
+	s.Require().Nil(it.Totals())
+	s.Require().Equal(DetailedReportEntry{}, it.Entry())
+	ids := make([]TimeEntryID, 0)
+	for it.Next() {
+		ids = append(ids, it.Entry().ID)
+	}
+	s.Require().Nil(it.Err())
+	s.Require().Equal([]TimeEntryID{"TimeEntry1", "TimeEntry2", "TimeEntry3", "TimeEntry4",
+		"TimeEntry5"}, ids)
+	s.Require().Equal(reportDetailedEntries, it.Totals().EntriesCount)
+	s.Require().False(it.Next())
+	s.Require().Equal(DetailedReportEntry{}, it.Entry())
+
+	s.Require().Len(s.requests, 3)
+	for i, request := range s.requests {
+		s.Require().Equal(i+1, request.DetailedFilter.Page)
+		s.Require().Equal(2, request.DetailedFilter.PageSize)
+	}
+	s.Require().Equal(reportTotalsCalculate, s.requests[0].DetailedFilter.Options.Totals)
+	s.Require().Equal(reportTotalsExclude, s.requests[2].DetailedFilter.Options.Totals)
+}
+
+func (s *ReportTestSuite) TestDetailedIteratorError() {
+	s.failPage = 2
+	it := s.glock().Report.DetailedIterator("Workspace1", time.Now().Add(-time.Hour),
+		time.Now(), WithPageSize(2))
+	count := 0
+	for it.Next() {
+		count++
+	}
+	s.Require().Equal(2, count)
+	s.Require().Error(it.Err())
+	s.Require().False(it.Next())
+}
+
+func TestReportNode(t *testing.T) {
+	suite.Run(t, &ReportTestSuite{})
+}
@@ -0,0 +1,103 @@
+package glockify
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"mime"
+	"net/http"
+	"reflect"
+	"strings"
+)
+
+const defaultMaxResponseSize = 32 << 20
+
+// Possible errors when reading response from Clockify.
+var (
+	ErrResponseTooLarge      = errors.New("response too large")
+	ErrUnexpectedContentType = errors.New("unexpected content type")
+)
+
+type responseOptions struct {
+	maxSize               int64
+	disallowUnknownFields bool
+	preserveUnknownFields bool
+}
+
+// WithMaxResponseSize set maximum bytes of response body read from Clockify.
+// Larger response fails with ErrResponseTooLarge. Zero or negative value
+// disable the limit. Default to 32 MiB.
+func WithMaxResponseSize(size int64) Option {
+	return func(g *Glockify) {
+		g.response.maxSize = size
+	}
+}
+
+// WithDisallowUnknownFields if set to true, decoding response that contains
+// fields unknown to the models fails, otherwise those fields are ignored.
+// Default to false.
+func WithDisallowUnknownFields(disallow bool) Option {
+	return func(g *Glockify) {
+		g.response.disallowUnknownFields = disallow
+	}
+}
+
+// checkContentType reject response that isn't JSON, eg: HTML error page
+// returned by proxy, so the caller get clear error instead of confusing
+// unmarshal error. Only application/json, media types with +json suffix and
+// empty content type are accepted.
+func checkContentType(resp *http.Response) error {
+	contentType := resp.Header.Get("Content-Type")
+	if contentType == "" {
+		return nil
+	}
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		return fmt.Errorf("%w: %q with status code %d", ErrUnexpectedContentType, contentType,
+			resp.StatusCode)
+	}
+	if mediaType == "application/json" || strings.HasSuffix(mediaType, "+json") {
+		return nil
+	}
+	return fmt.Errorf("%w: %s with status code %d", ErrUnexpectedContentType, mediaType,
+		resp.StatusCode)
+}
+
+func readBody(body io.Reader, maxSize int64) ([]byte, error) {
+	if maxSize <= 0 {
+		return ioutil.ReadAll(body)
+	}
+	respBytes, err := ioutil.ReadAll(io.LimitReader(body, maxSize+1))
+	if err != nil {
+		return nil, err
+	}
+	if int64(len(respBytes)) > maxSize {
+		return nil, fmt.Errorf("%w: exceed %d bytes", ErrResponseTooLarge, maxSize)
+	}
+	return respBytes, nil
+}
+
+// emptyBody report whether response has no body, such as 204 of delete request.
+func emptyBody(data []byte) bool {
+	return len(bytes.TrimSpace(data)) == 0
+}
+
+func (r *requester) unmarshal(data []byte, v interface{}) error {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	if r.response.disallowUnknownFields {
+		decoder.DisallowUnknownFields()
+	}
+	if err := decoder.Decode(v); err != nil {
+		if jErr, ok := err.(*json.UnmarshalTypeError); ok {
+			return fmt.Errorf("unmarshal field %v of type %v", jErr.Field, jErr.Type)
+		}
+		return fmt.Errorf("json unmarshal: %w", err)
+	}
+	if r.response.preserveUnknownFields && !r.response.disallowUnknownFields {
+		captureExtra(data, reflect.ValueOf(v))
+	}
+	return nil
+}
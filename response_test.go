@@ -0,0 +1,152 @@
+package glockify
+
+import (
+	"errors"
+	"fmt"
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/suite"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+type ResponseTestSuite struct {
+	suite.Suite
+	server *httptest.Server
+	body   string
+	header string
+	status int
+}
+
+func (s *ResponseTestSuite) SetupTest() {
+	testMux := mux.NewRouter()
+
+	testMux.HandleFunc("/workspaces", func(w http.ResponseWriter, r *http.Request) {
+		if s.header != "" {
+			w.Header().Set("Content-Type", s.header)
+		} else {
+			// Nil value prevent net/http from sniffing content type.
+			w.Header()["Content-Type"] = nil
+		}
+		w.WriteHeader(s.status)
+		_, err := fmt.Fprint(w, s.body)
+		s.Require().Nil(err)
+	})
+
+	s.server = httptest.NewServer(testMux)
+}
+
+func (s *ResponseTestSuite) TearDownTest() {
+	s.server.Close()
+}
+
+var testsResponse = []struct {
+	name        string
+	options     []Option
+	header      string
+	status      int
+	body        string
+	wantErr     error
+	wantErrText string
+	wantStatus  int
+}{
+	{
+		name:   "JSON",
+		header: "application/json",
+		status: http.StatusOK,
+		body:   `[{"id":"dummy","unknown":1}]`,
+	},
+	{
+		name:       "HTML Error Page",
+		header:     "text/html; charset=utf-8",
+		status:     http.StatusBadGateway,
+		body:       `<html><body>Bad Gateway</body></html>`,
+		wantStatus: http.StatusBadGateway,
+	},
+	{
+		name:       "Plain Text Not Found",
+		header:     "text/plain; charset=utf-8",
+		status:     http.StatusNotFound,
+		body:       `404 page not found`,
+		wantStatus: http.StatusNotFound,
+	},
+	{
+		name:    "HTML Success",
+		header:  "text/html; charset=utf-8",
+		status:  http.StatusOK,
+		body:    `<html><body>Login</body></html>`,
+		wantErr: ErrUnexpectedContentType,
+	},
+	{
+		name:   "JSON Suffix",
+		header: "application/problem+json",
+		status: http.StatusOK,
+		body:   `[]`,
+	},
+	{
+		name:   "Empty Content Type",
+		status: http.StatusOK,
+		body:   `[]`,
+	},
+	{
+		name:    "Plain Text",
+		header:  "text/plain; charset=utf-8",
+		status:  http.StatusOK,
+		body:    `[]`,
+		wantErr: ErrUnexpectedContentType,
+	},
+	{
+		name:    "Octet Stream",
+		header:  "application/octet-stream",
+		status:  http.StatusOK,
+		body:    `[]`,
+		wantErr: ErrUnexpectedContentType,
+	},
+	{
+		name:    "Too Large",
+		options: []Option{WithMaxResponseSize(8)},
+		header:  "application/json",
+		status:  http.StatusOK,
+		body:    `[{"id":"dummy"}]`,
+		wantErr: ErrResponseTooLarge,
+	},
+	{
+		name:        "Disallow Unknown Fields",
+		options:     []Option{WithDisallowUnknownFields(true)},
+		header:      "application/json",
+		status:      http.StatusOK,
+		body:        `[{"id":"dummy","unknown":1}]`,
+		wantErrText: "json unmarshal",
+	},
+}
+
+func (s *ResponseTestSuite) TestRead() {
+	for _, tc := range testsResponse {
+		s.Run(tc.name, func() {
+			s.header = tc.header
+			s.status = tc.status
+			s.body = tc.body
+			opts := append([]Option{WithEndpoint(Endpoint{Base: s.server.URL})}, tc.options...)
+			glock := New(dummyAPIKey, opts...)
+
+			_, err := glock.Workspace.All()
+			switch {
+			case tc.wantStatus != 0:
+				var httpErr *HTTPError
+				s.Require().True(errors.As(err, &httpErr))
+				s.Require().Equal(tc.wantStatus, httpErr.StatusCode)
+			case tc.wantErr != nil:
+				s.Require().True(errors.Is(err, tc.wantErr))
+			case tc.wantErrText != "":
+				s.Require().NotNil(err)
+				s.Require().Contains(err.Error(), tc.wantErrText)
+			default:
+				s.Require().Nil(err)
+			}
+		})
+	}
+}
+
+func TestResponse(t *testing.T) {
+	suite.Run(t, &ResponseTestSuite{})
+}
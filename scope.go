@@ -0,0 +1,322 @@
+package glockify
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+)
+
+// ErrNoActiveWorkspace returned when WorkspaceScope created with empty id and
+// current user has no active workspace.
+var ErrNoActiveWorkspace = errors.New("no active workspace")
+
+// activeWorkspace cache active workspace of current user.
+type activeWorkspace struct {
+	mu sync.Mutex
+	id WorkspaceID
+}
+
+// resolve return active workspace of current user, fetching it on first call.
+// Only context of opts is used to fetch current user, and the lock is not held
+// while fetching it.
+func (a *activeWorkspace) resolve(g *Glockify, opts []RequestOption) (WorkspaceID, error) {
+	a.mu.Lock()
+	id := a.id
+	a.mu.Unlock()
+	if id != "" {
+		return id, nil
+	}
+
+	contextOpts := make([]RequestOption, 0, len(opts))
+	for _, opt := range opts {
+		if opt.contextProvider != nil {
+			contextOpts = append(contextOpts, RequestOption{contextProvider: opt.contextProvider})
+		}
+	}
+	user, err := g.User.Current(contextOpts...)
+	if err != nil {
+		return "", err
+	}
+	id = user.ActiveWorkspace
+	if id == "" {
+		id = user.DefaultWorkspace
+	}
+	if id == "" {
+		return "", ErrNoActiveWorkspace
+	}
+
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	if a.id == "" {
+		a.id = id
+	}
+	return a.id, nil
+}
+
+// WorkspaceScope bind workspace to ClientNode, ProjectNode and TaskNode calls.
+type WorkspaceScope struct {
+	g  *Glockify
+	id WorkspaceID
+}
+
+// InWorkspace return WorkspaceScope of workspace given. Empty id means active
+// workspace of current user, which is fetched on first request and cached.
+func (g *Glockify) InWorkspace(id WorkspaceID) *WorkspaceScope {
+	return &WorkspaceScope{g: g, id: id}
+}
+
+// ID return workspace id of this scope, resolving active workspace of current
+// user when scope created with empty id.
+func (w *WorkspaceScope) ID(opts ...RequestOption) (WorkspaceID, error) {
+	if w.id != "" {
+		return w.id, nil
+	}
+	id, err := w.g.active.resolve(w.g, opts)
+	if err != nil {
+		return "", fmt.Errorf("resolve workspace: %w", err)
+	}
+	return id, nil
+}
+
+// Clients return ClientNode calls bound to this workspace.
+func (w *WorkspaceScope) Clients() *ClientScope {
+	return &ClientScope{workspace: w}
+}
+
+// Projects return ProjectNode calls bound to this workspace.
+func (w *WorkspaceScope) Projects() *ProjectScope {
+	return &ProjectScope{workspace: w}
+}
+
+// Tasks return TaskNode calls bound to this workspace.
+func (w *WorkspaceScope) Tasks() *TaskScope {
+	return &TaskScope{workspace: w}
+}
+
+// Project return scope of project given within this workspace.
+func (w *WorkspaceScope) Project(id ProjectID) *ProjectInScope {
+	return &ProjectInScope{workspace: w, id: id}
+}
+
+// ClientScope is ClientNode bound to workspace.
+type ClientScope struct {
+	workspace *WorkspaceScope
+}
+
+// All see: ClientNode.All.
+func (c *ClientScope) All(opts ...RequestOption) ([]Client, error) {
+	workspaceID, err := c.workspace.ID(opts...)
+	if err != nil {
+		return nil, err
+	}
+	return c.workspace.g.Client.All(workspaceID, opts...)
+}
+
+// Get see: ClientNode.Get.
+func (c *ClientScope) Get(id ClientID, opts ...RequestOption) (*Client, error) {
+	workspaceID, err := c.workspace.ID(opts...)
+	if err != nil {
+		return nil, err
+	}
+	return c.workspace.g.Client.Get(workspaceID, id, opts...)
+}
+
+// Add see: ClientNode.Add.
+func (c *ClientScope) Add(name string, opts ...RequestOption) (*Client, error) {
+	workspaceID, err := c.workspace.ID(opts...)
+	if err != nil {
+		return nil, err
+	}
+	return c.workspace.g.Client.Add(workspaceID, name, opts...)
+}
+
+// Update see: ClientNode.Update.
+func (c *ClientScope) Update(id ClientID, opts ...RequestOption) (*Client, error) {
+	workspaceID, err := c.workspace.ID(opts...)
+	if err != nil {
+		return nil, err
+	}
+	return c.workspace.g.Client.Update(workspaceID, id, opts...)
+}
+
+// Delete see: ClientNode.Delete.
+func (c *ClientScope) Delete(id ClientID, opts ...RequestOption) (*Client, error) {
+	workspaceID, err := c.workspace.ID(opts...)
+	if err != nil {
+		return nil, err
+	}
+	return c.workspace.g.Client.Delete(workspaceID, id, opts...)
+}
+
+// ProjectScope is ProjectNode bound to workspace.
+type ProjectScope struct {
+	workspace *WorkspaceScope
+}
+
+// All see: ProjectNode.All.
+func (p *ProjectScope) All(opts ...RequestOption) ([]Project, error) {
+	workspaceID, err := p.workspace.ID(opts...)
+	if err != nil {
+		return nil, err
+	}
+	return p.workspace.g.Project.All(workspaceID, opts...)
+}
+
+// Get see: ProjectNode.Get.
+func (p *ProjectScope) Get(id ProjectID, opts ...RequestOption) (*Project, error) {
+	workspaceID, err := p.workspace.ID(opts...)
+	if err != nil {
+		return nil, err
+	}
+	return p.workspace.g.Project.Get(workspaceID, id, opts...)
+}
+
+// Add see: ProjectNode.Add.
+func (p *ProjectScope) Add(name string, opts ...RequestOption) (*Project, error) {
+	workspaceID, err := p.workspace.ID(opts...)
+	if err != nil {
+		return nil, err
+	}
+	return p.workspace.g.Project.Add(workspaceID, name, opts...)
+}
+
+// Update see: ProjectNode.Update.
+func (p *ProjectScope) Update(id ProjectID, opts ...RequestOption) (*Project, error) {
+	workspaceID, err := p.workspace.ID(opts...)
+	if err != nil {
+		return nil, err
+	}
+	return p.workspace.g.Project.Update(workspaceID, id, opts...)
+}
+
+// UpdateEstimate see: ProjectNode.UpdateEstimate.
+func (p *ProjectScope) UpdateEstimate(id ProjectID, opts ...RequestOption) (*Project, error) {
+	workspaceID, err := p.workspace.ID(opts...)
+	if err != nil {
+		return nil, err
+	}
+	return p.workspace.g.Project.UpdateEstimate(workspaceID, id, opts...)
+}
+
+// UpdateMemberships see: ProjectNode.UpdateMemberships.
+func (p *ProjectScope) UpdateMemberships(id ProjectID, opts ...RequestOption) (*Project,
+	error) {
+	workspaceID, err := p.workspace.ID(opts...)
+	if err != nil {
+		return nil, err
+	}
+	return p.workspace.g.Project.UpdateMemberships(workspaceID, id, opts...)
+}
+
+// UpdateTemplate see: ProjectNode.UpdateTemplate.
+func (p *ProjectScope) UpdateTemplate(id ProjectID, opts ...RequestOption) (*Project, error) {
+	workspaceID, err := p.workspace.ID(opts...)
+	if err != nil {
+		return nil, err
+	}
+	return p.workspace.g.Project.UpdateTemplate(workspaceID, id, opts...)
+}
+
+// Delete see: ProjectNode.Delete.
+func (p *ProjectScope) Delete(id ProjectID, opts ...RequestOption) (*Project, error) {
+	workspaceID, err := p.workspace.ID(opts...)
+	if err != nil {
+		return nil, err
+	}
+	return p.workspace.g.Project.Delete(workspaceID, id, opts...)
+}
+
+// TaskScope is TaskNode bound to workspace.
+type TaskScope struct {
+	workspace *WorkspaceScope
+}
+
+// All see: TaskNode.All.
+func (t *TaskScope) All(projectID ProjectID, opts ...RequestOption) ([]Task, error) {
+	return t.workspace.Project(projectID).Tasks().All(opts...)
+}
+
+// Get see: TaskNode.Get.
+func (t *TaskScope) Get(projectID ProjectID, id TaskID, opts ...RequestOption) (*Task, error) {
+	return t.workspace.Project(projectID).Tasks().Get(id, opts...)
+}
+
+// Add see: TaskNode.Add.
+func (t *TaskScope) Add(projectID ProjectID, name string, opts ...RequestOption) (*Task,
+	error) {
+	return t.workspace.Project(projectID).Tasks().Add(name, opts...)
+}
+
+// Update see: TaskNode.Update.
+func (t *TaskScope) Update(projectID ProjectID, id TaskID, opts ...RequestOption) (*Task,
+	error) {
+	return t.workspace.Project(projectID).Tasks().Update(id, opts...)
+}
+
+// Delete see: TaskNode.Delete.
+func (t *TaskScope) Delete(projectID ProjectID, id TaskID, opts ...RequestOption) (*Task,
+	error) {
+	return t.workspace.Project(projectID).Tasks().Delete(id, opts...)
+}
+
+// ProjectInScope is project within WorkspaceScope.
+type ProjectInScope struct {
+	workspace *WorkspaceScope
+	id        ProjectID
+}
+
+// Tasks return TaskNode calls bound to this project.
+func (p *ProjectInScope) Tasks() *ProjectTaskScope {
+	return &ProjectTaskScope{project: p}
+}
+
+// ProjectTaskScope is TaskNode bound to workspace and project.
+type ProjectTaskScope struct {
+	project *ProjectInScope
+}
+
+// All see: TaskNode.All.
+func (t *ProjectTaskScope) All(opts ...RequestOption) ([]Task, error) {
+	workspaceID, err := t.project.workspace.ID(opts...)
+	if err != nil {
+		return nil, err
+	}
+	return t.project.workspace.g.Task.All(workspaceID, t.project.id, opts...)
+}
+
+// Get see: TaskNode.Get.
+func (t *ProjectTaskScope) Get(id TaskID, opts ...RequestOption) (*Task, error) {
+	workspaceID, err := t.project.workspace.ID(opts...)
+	if err != nil {
+		return nil, err
+	}
+	return t.project.workspace.g.Task.Get(workspaceID, t.project.id, id, opts...)
+}
+
+// Add see: TaskNode.Add.
+func (t *ProjectTaskScope) Add(name string, opts ...RequestOption) (*Task, error) {
+	workspaceID, err := t.project.workspace.ID(opts...)
+	if err != nil {
+		return nil, err
+	}
+	return t.project.workspace.g.Task.Add(workspaceID, t.project.id, name, opts...)
+}
+
+// Update see: TaskNode.Update.
+func (t *ProjectTaskScope) Update(id TaskID, opts ...RequestOption) (*Task, error) {
+	workspaceID, err := t.project.workspace.ID(opts...)
+	if err != nil {
+		return nil, err
+	}
+	return t.project.workspace.g.Task.Update(workspaceID, t.project.id, id, opts...)
+}
+
+// Delete see: TaskNode.Delete.
+func (t *ProjectTaskScope) Delete(id TaskID, opts ...RequestOption) (*Task, error) {
+	workspaceID, err := t.project.workspace.ID(opts...)
+	if err != nil {
+		return nil, err
+	}
+	return t.project.workspace.g.Task.Delete(workspaceID, t.project.id, id, opts...)
+}
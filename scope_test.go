@@ -0,0 +1,107 @@
+package glockify
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/suite"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+type ScopeTestSuite struct {
+	suite.Suite
+	server          *httptest.Server
+	activeWorkspace string
+	userCalls       int
+}
+
+func (s *ScopeTestSuite) SetupTest() {
+	s.activeWorkspace = "Workspace1"
+	s.userCalls = 0
+
+	testMux := mux.NewRouter()
+	testMux.HandleFunc("/user", func(w http.ResponseWriter, r *http.Request) {
+		s.Require().True(checkAuthHeader(r))
+		s.Require().Empty(r.URL.Query())
+		s.userCalls++
+		_, err := fmt.Fprintf(w, `{"id":"User1","activeWorkspace":%q}`, s.activeWorkspace)
+		s.Require().Nil(err)
+	}).Methods("GET")
+	testMux.HandleFunc("/workspaces/{workspaceID}/clients",
+		func(w http.ResponseWriter, r *http.Request) {
+			_, err := fmt.Fprintf(w, `[{"id":"Client1","workspaceId":%q}]`,
+				mux.Vars(r)["workspaceID"])
+			s.Require().Nil(err)
+		}).Methods("GET")
+	testMux.HandleFunc("/workspaces/{workspaceID}/projects/{projectID}/tasks",
+		func(w http.ResponseWriter, r *http.Request) {
+			path := mux.Vars(r)
+			s.Require().Equal("Workspace2", path["workspaceID"])
+			_, err := fmt.Fprintf(w, `[{"id":"Task1","projectId":%q}]`, path["projectID"])
+			s.Require().Nil(err)
+		}).Methods("GET")
+
+	s.server = newJSONServer(testMux)
+}
+
+func (s *ScopeTestSuite) TearDownTest() {
+	s.server.Close()
+}
+
+func (s *ScopeTestSuite) TestActiveWorkspace() {
+	glock := New(dummyAPIKey, WithEndpoint(Endpoint{Base: s.server.URL}))
+	scope := glock.InWorkspace("")
+
+	for i := 0; i < 2; i++ {
+		clients, err := scope.Clients().All()
+		s.Require().Nil(err)
+		s.Require().Len(clients, 1)
+		s.Require().Equal(WorkspaceID("Workspace1"), clients[0].WorkspaceID)
+	}
+	s.Require().Equal(1, s.userCalls)
+}
+
+func (s *ScopeTestSuite) TestActiveWorkspaceOptions() {
+	glock := New(dummyAPIKey, WithEndpoint(Endpoint{Base: s.server.URL}))
+	scope := glock.InWorkspace("")
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err := scope.Clients().All(WithName("Client1"), WithContext(ctx))
+	s.Require().True(errors.Is(err, context.Canceled))
+	s.Require().Equal(0, s.userCalls)
+
+	clients, err := scope.Clients().All(WithName("Client1"), WithContext(context.Background()))
+	s.Require().Nil(err)
+	s.Require().Len(clients, 1)
+	s.Require().Equal(1, s.userCalls)
+}
+
+func (s *ScopeTestSuite) TestNoActiveWorkspace() {
+	s.activeWorkspace = ""
+	glock := New(dummyAPIKey, WithEndpoint(Endpoint{Base: s.server.URL}))
+
+	_, err := glock.InWorkspace("").Clients().All()
+	s.Require().True(errors.Is(err, ErrNoActiveWorkspace))
+}
+
+func (s *ScopeTestSuite) TestProjectTasks() {
+	glock := New(dummyAPIKey, WithEndpoint(Endpoint{Base: s.server.URL}))
+	scope := glock.InWorkspace("Workspace2")
+
+	tasks, err := scope.Project("Project1").Tasks().All()
+	s.Require().Nil(err)
+	s.Require().Equal(ProjectID("Project1"), tasks[0].ProjectID)
+
+	tasks, err = scope.Tasks().All("Project2")
+	s.Require().Nil(err)
+	s.Require().Equal(ProjectID("Project2"), tasks[0].ProjectID)
+	s.Require().Equal(0, s.userCalls)
+}
+
+func TestScope(t *testing.T) {
+	suite.Run(t, &ScopeTestSuite{})
+}
@@ -0,0 +1,220 @@
+package glockify
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strconv"
+)
+
+// TagNode manipulating Tag resource.
+type TagNode struct {
+	endpoint  string
+	requester *requester
+}
+
+// Tag represent Clockify's tag resource.
+// See: https://clockify.me/developers-api#tag-Tag
+type Tag struct {
+	ID          TagID       `json:"id,omitempty"`
+	Name        string      `json:"name,omitempty"`
+	WorkspaceID WorkspaceID `json:"workspaceId,omitempty"`
+	Archived    bool        `json:"archived,omitempty"`
+
+	// Extra holds JSON properties unknown to this package.
+	// See: WithPreserveUnknownFields.
+	Extra map[string]json.RawMessage `json:"-"`
+}
+
+type TagSortColumn string
+
+const (
+	TagSortColumnName TagSortColumn = "NAME"
+)
+
+// WithTagSortColumn set fields you want to sort against.
+func WithTagSortColumn(sortColumn TagSortColumn) RequestOption {
+	return RequestOption{
+		paramsProvider: func(v url.Values) string {
+			v.Set(sortColumnKey, string(sortColumn))
+			return sortColumnKey
+		},
+	}
+}
+
+type tagAddFields struct {
+	Name string `json:"name"`
+}
+
+type tagUpdateFields struct {
+	Archived *bool   `json:"archived,omitempty"`
+	Name     *string `json:"name,omitempty"`
+}
+
+// All get all Tag resource based on filter given.
+func (t *TagNode) All(workspaceID WorkspaceID, opts ...RequestOption) ([]Tag, error) {
+	endpoint := fmt.Sprintf("%s/workspaces/%s/tags", t.endpoint, workspaceID)
+	res, err := t.requester.get(tagAllRequest(endpoint, opts))
+	if err != nil {
+		return nil, fmt.Errorf("get: %w", err)
+	}
+	result := make([]Tag, 0)
+	if err := t.requester.unmarshal(res, &result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func tagAllRequest(endpoint string, options []RequestOption) requestOptions {
+	res := requestOptions{
+		resource:  ResourceTag,
+		operation: OperationList,
+		endpoint:  endpoint,
+	}
+	res.params = url.Values{}
+	res.params.Add(archivedKey, strconv.FormatBool(false))
+	res.params.Add(pageKey, strconv.Itoa(defaultPage))
+	res.params.Add(pageSizeKey, strconv.Itoa(defaultPageSize))
+	res.params.Add(sortOrderKey, string(defaultSortOrder))
+	for _, opt := range options {
+		if opt.paramsProvider != nil {
+			opt.paramsProvider(res.params)
+		}
+	}
+	injectContext(&res, options)
+
+	return res
+}
+
+// Get one Tag by its id.
+func (t *TagNode) Get(workspaceID WorkspaceID, id TagID, opts ...RequestOption) (*Tag, error) {
+	endpoint := fmt.Sprintf("%s/workspaces/%s/tags/%s", t.endpoint, workspaceID, id)
+	res, err := t.requester.get(tagGetRequest(endpoint, opts))
+	if err != nil {
+		return nil, fmt.Errorf("get: %w", err)
+	}
+	result := new(Tag)
+	if err := t.requester.unmarshal(res, &result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func tagGetRequest(endpoint string, options []RequestOption) requestOptions {
+	res := requestOptions{
+		resource: ResourceTag,
+		endpoint: endpoint,
+	}
+	res.params = url.Values{}
+	injectContext(&res, options)
+
+	return res
+}
+
+// Add create new Tag based on fields given.
+func (t *TagNode) Add(workspaceID WorkspaceID, name string, opts ...RequestOption) (*Tag,
+	error) {
+	endpoint := fmt.Sprintf("%s/workspaces/%s/tags", t.endpoint, workspaceID)
+	res, err := t.requester.post(tagAddRequest(endpoint, name, opts))
+	if err != nil {
+		return nil, fmt.Errorf("post: %w", err)
+	}
+	result := new(Tag)
+	if err := t.requester.unmarshal(res, &result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func tagAddRequest(endpoint string, name string, options []RequestOption) requestOptions {
+	res := requestOptions{
+		resource: ResourceTag,
+		endpoint: endpoint,
+	}
+	res.fields = tagAddFields{Name: name}
+	injectContext(&res, options)
+
+	return res
+}
+
+// Update existing Tag based on options given. Only fields set by options are
+// sent, so fields not given are left unchanged.
+func (t *TagNode) Update(workspaceID WorkspaceID, id TagID, opts ...RequestOption) (*Tag,
+	error) {
+	endpoint := fmt.Sprintf("%s/workspaces/%s/tags/%s", t.endpoint, workspaceID, id)
+	res, err := t.requester.put(tagUpdateRequest(endpoint, opts))
+	if err != nil {
+		return nil, fmt.Errorf("put: %w", err)
+	}
+	result := new(Tag)
+	if err := t.requester.unmarshal(res, &result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func tagUpdateRequest(endpoint string, options []RequestOption) requestOptions {
+	res := requestOptions{
+		resource: ResourceTag,
+		endpoint: endpoint,
+	}
+	res.params = url.Values{}
+	for _, opt := range options {
+		if opt.paramsProvider != nil {
+			opt.paramsProvider(res.params)
+		}
+	}
+
+	fields := tagUpdateFields{}
+	for _, opt := range options {
+		if opt.paramsProvider != nil {
+			params := url.Values{}
+			key := opt.paramsProvider(params)
+			switch key {
+			case archivedKey:
+				val, _ := strconv.ParseBool(params.Get(key))
+				fields.Archived = &val
+			case nameKey:
+				val := params.Get(key)
+				fields.Name = &val
+			}
+		}
+	}
+	res.fields = withExtraFields(fields, res.params)
+	res.params.Del(archivedKey)
+	res.params.Del(nameKey)
+	res.params.Del(extraFieldsKey)
+
+	injectContext(&res, options)
+
+	return res
+}
+
+// Delete existing Tag, and return the deleted Tag. Nil Tag is returned when
+// Clockify respond without body.
+func (t *TagNode) Delete(workspaceID WorkspaceID, id TagID, opts ...RequestOption) (*Tag,
+	error) {
+	endpoint := fmt.Sprintf("%s/workspaces/%s/tags/%s", t.endpoint, workspaceID, id)
+	res, err := t.requester.del(tagDeleteRequest(endpoint, opts))
+	if err != nil {
+		return nil, fmt.Errorf("del: %w", err)
+	}
+	if emptyBody(res) {
+		return nil, nil
+	}
+	result := new(Tag)
+	if err := t.requester.unmarshal(res, &result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func tagDeleteRequest(endpoint string, options []RequestOption) requestOptions {
+	res := requestOptions{
+		resource: ResourceTag,
+		endpoint: endpoint,
+	}
+	injectContext(&res, options)
+
+	return res
+}
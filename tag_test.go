@@ -0,0 +1,172 @@
+package glockify
+
+import (
+	"fmt"
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/suite"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+)
+
+type TagTestSuite struct {
+	suite.Suite
+	server    tagMockServer
+	testIndex int
+	wantBody  string
+}
+
+type tagMockServer struct {
+	baseServer *httptest.Server
+}
+
+func (s *TagTestSuite) SetupTest() {
+	s.wantBody = ""
+
+	testMux := mux.NewRouter()
+
+	testMux.HandleFunc("/workspaces/{workspaceID}/tags", s.all()).Methods("GET")
+	testMux.HandleFunc("/workspaces/{workspaceID}/tags/{tagID}", s.write()).Methods("GET")
+	testMux.HandleFunc("/workspaces/{workspaceID}/tags", s.write()).Methods("POST")
+	testMux.HandleFunc("/workspaces/{workspaceID}/tags/{tagID}", s.write()).Methods("PUT")
+	testMux.HandleFunc("/workspaces/{workspaceID}/tags/{tagID}", s.write()).Methods("DELETE")
+
+	s.server = tagMockServer{
+		baseServer: newJSONServer(testMux),
+	}
+}
+
+func (s *TagTestSuite) TearDownTest() {
+	s.server.baseServer.Close()
+}
+
+var testsTagAll = []struct {
+	name       string
+	options    []RequestOption
+	wantParams url.Values
+}{
+	{
+		name: "Default Params",
+		wantParams: map[string][]string{
+			"archived":   {"false"},
+			"page":       {"1"},
+			"page-size":  {"50"},
+			"sort-order": {"DESCENDING"},
+		},
+	},
+	{
+		name: "Set Filters",
+		options: []RequestOption{
+			WithName("Tag"),
+			WithArchived(true),
+			WithPage(2),
+			WithTagSortColumn(TagSortColumnName),
+		},
+		wantParams: map[string][]string{
+			"archived":    {"true"},
+			"name":        {"Tag"},
+			"page":        {"2"},
+			"page-size":   {"50"},
+			"sort-column": {"NAME"},
+			"sort-order":  {"DESCENDING"},
+		},
+	},
+}
+
+func (s *TagTestSuite) all() func(http.ResponseWriter, *http.Request) {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if !checkAuthHeader(r) {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		test := testsTagAll[s.testIndex]
+		s.Require().Equal("Workspace1", mux.Vars(r)["workspaceID"])
+		s.Require().Equal(test.wantParams, r.URL.Query())
+
+		_, err := fmt.Fprintf(w, `[{"id":"Tag1","name":"Tag"}]`)
+		s.Require().Nil(err)
+	}
+}
+
+func (s *TagTestSuite) write() func(http.ResponseWriter, *http.Request) {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if !checkAuthHeader(r) {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		body, err := ioutil.ReadAll(r.Body)
+		s.Require().Nil(err)
+		if s.wantBody == "" {
+			s.Require().Empty(body)
+		} else {
+			s.Require().JSONEq(s.wantBody, string(body))
+		}
+
+		if r.Method == http.MethodPost {
+			w.WriteHeader(http.StatusCreated)
+		}
+		if r.Method == http.MethodDelete && mux.Vars(r)["tagID"] != "Tag1" {
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+		_, err = fmt.Fprintf(w, `{"id":"Tag1","name":"Tag","workspaceId":"Workspace1"}`)
+		s.Require().Nil(err)
+	}
+}
+
+func (s *TagTestSuite) glock() *Glockify {
+	return New(dummyAPIKey, WithEndpoint(Endpoint{
+		Base: s.server.baseServer.URL,
+	}))
+}
+
+func (s *TagTestSuite) TestAll() {
+	glock := s.glock()
+	for index, tc := range testsTagAll {
+		s.Run(tc.name, func() {
+			s.testIndex = index
+			tags, err := glock.Tag.All("Workspace1", tc.options...)
+			s.Require().Nil(err)
+			s.Require().Equal(TagID("Tag1"), tags[0].ID)
+		})
+	}
+}
+
+func (s *TagTestSuite) TestGet() {
+	tag, err := s.glock().Tag.Get("Workspace1", "Tag1")
+	s.Require().Nil(err)
+	s.Require().Equal(WorkspaceID("Workspace1"), tag.WorkspaceID)
+}
+
+func (s *TagTestSuite) TestAdd() {
+	s.wantBody = `{"name":"Tag"}`
+	tag, err := s.glock().Tag.Add("Workspace1", "Tag")
+	s.Require().Nil(err)
+	s.Require().Equal("Tag", tag.Name)
+}
+
+func (s *TagTestSuite) TestUpdate() {
+	s.wantBody = `{"archived":true}`
+	_, err := s.glock().Tag.Update("Workspace1", "Tag1", WithArchived(true))
+	s.Require().Nil(err)
+
+	s.wantBody = `{"name":"Renamed"}`
+	_, err = s.glock().Tag.Update("Workspace1", "Tag1", WithName("Renamed"))
+	s.Require().Nil(err)
+}
+
+func (s *TagTestSuite) TestDelete() {
+	tag, err := s.glock().Tag.Delete("Workspace1", "Tag1")
+	s.Require().Nil(err)
+	s.Require().Equal(TagID("Tag1"), tag.ID)
+
+	tag, err = s.glock().Tag.Delete("Workspace1", "Tag2")
+	s.Require().Nil(err)
+	s.Require().Nil(tag)
+}
+
+func TestTagNode(t *testing.T) {
+	suite.Run(t, &TagTestSuite{})
+}
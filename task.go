@@ -10,29 +10,30 @@ import (
 
 // TaskNode manipulating Task resource.
 type TaskNode struct {
-	endpoint string
-	apiKey   string
+	endpoint  string
+	requester *requester
 }
 
-// Task represents Clockify's task resource.
+// Task represents Clockify's task resource, returned by TaskNode and within
+// hydrated Project.
 // See: https://clockify.me/developers-api#tag-Task
 type Task struct {
-	AssigneeIds []string   `json:"assigneeIds,omitempty"`
-	Estimate    string     `json:"estimate,omitempty"`
-	ID          string     `json:"id,omitempty"`
-	Name        string     `json:"name,omitempty"`
-	ProjectID   string     `json:"projectId,omitempty"`
-	Billable    bool       `json:"billable,omitempty"`
-	HourlyRate  HourlyRate `json:"hourlyRate,omitempty"`
-	CostRate    CostRate   `json:"costRate,omitempty"`
-	Status      string     `json:"status,omitempty"`
-}
-
-// CostRate wraps Clockify's cost rate resource.
-// See: https://clockify.me/developers-api#tag-Cost
-type CostRate struct {
-	Amount   string `json:"amount,omitempty"`
-	Currency string `json:"currency,omitempty"`
+	ID           TaskID        `json:"id,omitempty"`
+	Name         string        `json:"name,omitempty"`
+	ProjectID    ProjectID     `json:"projectId,omitempty"`
+	AssigneeIds  []UserID      `json:"assigneeIds,omitempty"`
+	AssigneeID   UserID        `json:"assigneeId,omitempty"`
+	UserGroupIds []UserGroupID `json:"userGroupIds,omitempty"`
+	Estimate     Duration      `json:"estimate,omitempty"`
+	Status       TaskStatus    `json:"status,omitempty"`
+	Duration     Duration      `json:"duration,omitempty"`
+	Billable     bool          `json:"billable,omitempty"`
+	HourlyRate   Money         `json:"hourlyRate,omitempty"`
+	CostRate     Money         `json:"costRate,omitempty"`
+
+	// Extra holds JSON properties unknown to this package.
+	// See: WithPreserveUnknownFields.
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 const (
@@ -69,7 +70,7 @@ type TaskSortColumn string
 // Possible value of TaskSortColumn
 const (
 	TaskSortColumnID   TaskSortColumn = "ID"
-	TaskSortColumnName                = "NAME"
+	TaskSortColumnName TaskSortColumn = "NAME"
 )
 
 // WithTaskSortColumn set fields you want to sort against.
@@ -83,20 +84,24 @@ func WithTaskSortColumn(sortColumn TaskSortColumn) RequestOption {
 }
 
 // WithAssigneeIDs set assignees for this task.
-func WithAssigneeIDs(ids []string) RequestOption {
+func WithAssigneeIDs(ids []UserID) RequestOption {
 	return RequestOption{
 		paramsProvider: func(v url.Values) string {
-			v.Set(assigneeIDsKey, strings.Join(ids, arraySeparator))
+			values := make([]string, len(ids))
+			for i, id := range ids {
+				values[i] = string(id)
+			}
+			v.Set(assigneeIDsKey, strings.Join(values, arraySeparator))
 			return assigneeIDsKey
 		},
 	}
 }
 
-// WithEstimate set task estimate in Clockify time format. Eg: "PT2H" for 2 hour.
-func WithEstimate(estimate string) RequestOption {
+// WithEstimate set task estimate, eg: Duration(2 * time.Hour) for 2 hour.
+func WithEstimate(estimate Duration) RequestOption {
 	return RequestOption{
 		paramsProvider: func(v url.Values) string {
-			v.Set(estimateKey, estimate)
+			v.Set(estimateKey, estimate.String())
 			return estimateKey
 		},
 	}
@@ -104,11 +109,21 @@ func WithEstimate(estimate string) RequestOption {
 
 type TaskStatus string
 
+// Possible value of TaskStatus
 const (
-	TaskStatusActive TaskStatus = "ACTIVE"
-	TaskStatusDone              = "DONE"
+	TaskStatusActive  TaskStatus = "ACTIVE"
+	TaskStatusDone    TaskStatus = "DONE"
+	TaskStatusUnknown TaskStatus = enumUnknown
 )
 
+// UnmarshalJSON decode TaskStatus, unknown value decode into TaskStatusUnknown.
+func (t *TaskStatus) UnmarshalJSON(data []byte) error {
+	v, err := unmarshalEnum(data, string(TaskStatusUnknown), string(TaskStatusActive),
+		string(TaskStatusDone))
+	*t = TaskStatus(v)
+	return err
+}
+
 // WithStatus set task state.
 func WithStatus(status TaskStatus) RequestOption {
 	return RequestOption{
@@ -119,6 +134,25 @@ func WithStatus(status TaskStatus) RequestOption {
 	}
 }
 
+// UpdateOptions return options that set every updatable field of TaskNode.Update
+// to the value of t, so task fetched from hydrated Project can be updated
+// directly: g.Task.Update(workspaceID, t.ProjectID, t.ID, t.UpdateOptions()...).
+func (t Task) UpdateOptions() []RequestOption {
+	opts := []RequestOption{
+		WithName(t.Name),
+		WithAssigneeIDs(t.AssigneeIds),
+		WithEstimate(t.Estimate),
+		WithBillable(t.Billable),
+	}
+	if t.Status != "" && t.Status != TaskStatusUnknown {
+		opts = append(opts, WithStatus(t.Status))
+	}
+	if len(t.Extra) > 0 {
+		opts = append(opts, WithExtraFields(t.Extra))
+	}
+	return opts
+}
+
 type taskAddFields struct {
 	Name        string   `json:"name"`
 	AssigneeIds []string `json:"assigneeIds,omitempty"`
@@ -127,41 +161,39 @@ type taskAddFields struct {
 }
 
 type taskUpdateFields struct {
-	Name        string   `json:"name,omitempty"`
-	AssigneeIds []string `json:"assigneeIds,omitempty"`
-	Estimate    string   `json:"estimate,omitempty"`
-	Billable    *bool    `json:"billable,omitempty"`
-	Status      string   `json:"status,omitempty"`
+	Name        *string   `json:"name,omitempty"`
+	AssigneeIds *[]string `json:"assigneeIds,omitempty"`
+	Estimate    *string   `json:"estimate,omitempty"`
+	Billable    *bool     `json:"billable,omitempty"`
+	Status      *string   `json:"status,omitempty"`
 }
 
 // All get all Task resource based on filter given.
-func (t *TaskNode) All(workspaceID string, projectID string, opts ...RequestOption) ([]Task,
+func (t *TaskNode) All(workspaceID WorkspaceID, projectID ProjectID, opts ...RequestOption) ([]Task,
 	error) {
 	endpoint := fmt.Sprintf("%s/workspaces/%s/projects/%s/tasks", t.endpoint,
 		workspaceID, projectID)
-	res, err := get(taskAllRequest(t.apiKey, endpoint, opts))
+	res, err := t.requester.get(taskAllRequest(endpoint, opts))
 	if err != nil {
 		return nil, fmt.Errorf("get: %w", err)
 	}
 	result := make([]Task, 0)
-	if err := json.Unmarshal(res, &result); err != nil {
-		if jErr, ok := err.(*json.UnmarshalTypeError); ok {
-			return nil, fmt.Errorf("unmarshal field %v of type %v", jErr.Field, jErr.Type)
-		}
-		return nil, fmt.Errorf("json unmarshal: %w", err)
+	if err := t.requester.unmarshal(res, &result); err != nil {
+		return nil, err
 	}
 	return result, nil
 }
 
-func taskAllRequest(apiKey string, endpoint string, options []RequestOption) requestOptions {
+func taskAllRequest(endpoint string, options []RequestOption) requestOptions {
 	res := requestOptions{
-		apiKey:   apiKey,
-		endpoint: endpoint,
+		resource:  ResourceTask,
+		operation: OperationList,
+		endpoint:  endpoint,
 	}
 	res.params = url.Values{}
 	res.params.Add(pageKey, strconv.Itoa(defaultPage))
 	res.params.Add(pageSizeKey, strconv.Itoa(defaultPageSize))
-	res.params.Add(sortOrderKey, defaultSortOrder)
+	res.params.Add(sortOrderKey, string(defaultSortOrder))
 	for _, opt := range options {
 		if opt.paramsProvider != nil {
 			opt.paramsProvider(res.params)
@@ -173,27 +205,24 @@ func taskAllRequest(apiKey string, endpoint string, options []RequestOption) req
 }
 
 // Get one Task by its id.
-func (t *TaskNode) Get(workspaceID string, projectID string, id string,
+func (t *TaskNode) Get(workspaceID WorkspaceID, projectID ProjectID, id TaskID,
 	opts ...RequestOption) (*Task, error) {
 	endpoint := fmt.Sprintf("%s/workspaces/%s/projects/%s/tasks/%s", t.endpoint, workspaceID,
 		projectID, id)
-	res, err := get(taskGetRequest(t.apiKey, endpoint, opts))
+	res, err := t.requester.get(taskGetRequest(endpoint, opts))
 	if err != nil {
 		return nil, fmt.Errorf("get: %w", err)
 	}
 	result := new(Task)
-	if err := json.Unmarshal(res, &result); err != nil {
-		if jErr, ok := err.(*json.UnmarshalTypeError); ok {
-			return nil, fmt.Errorf("unmarshal field %v of type %v", jErr.Field, jErr.Type)
-		}
-		return nil, fmt.Errorf("json unmarshal: %w", err)
+	if err := t.requester.unmarshal(res, &result); err != nil {
+		return nil, err
 	}
 	return result, nil
 }
 
-func taskGetRequest(apiKey string, endpoint string, options []RequestOption) requestOptions {
+func taskGetRequest(endpoint string, options []RequestOption) requestOptions {
 	res := requestOptions{
-		apiKey:   apiKey,
+		resource: ResourceTask,
 		endpoint: endpoint,
 	}
 	res.params = url.Values{}
@@ -203,28 +232,25 @@ func taskGetRequest(apiKey string, endpoint string, options []RequestOption) req
 }
 
 // Add create new Task based on fields given.
-func (t *TaskNode) Add(workspaceID string, projectID string, name string,
+func (t *TaskNode) Add(workspaceID WorkspaceID, projectID ProjectID, name string,
 	opts ...RequestOption) (*Task, error) {
 	endpoint := fmt.Sprintf("%s/workspaces/%s/projects/%s/tasks", t.endpoint,
 		workspaceID, projectID)
-	res, err := post(taskAddRequest(t.apiKey, endpoint, name, opts))
+	res, err := t.requester.post(taskAddRequest(endpoint, name, opts))
 	if err != nil {
 		return nil, fmt.Errorf("post: %w", err)
 	}
 	result := new(Task)
-	if err := json.Unmarshal(res, &result); err != nil {
-		if jErr, ok := err.(*json.UnmarshalTypeError); ok {
-			return nil, fmt.Errorf("unmarshal field %v of type %v", jErr.Field, jErr.Type)
-		}
-		return nil, fmt.Errorf("json unmarshal: %w", err)
+	if err := t.requester.unmarshal(res, &result); err != nil {
+		return nil, err
 	}
 	return result, nil
 }
 
-func taskAddRequest(apiKey string, endpoint string, name string,
+func taskAddRequest(endpoint string, name string,
 	options []RequestOption) requestOptions {
 	res := requestOptions{
-		apiKey:   apiKey,
+		resource: ResourceTask,
 		endpoint: endpoint,
 	}
 	fields := taskAddFields{Name: name}
@@ -234,7 +260,7 @@ func taskAddRequest(apiKey string, endpoint string, name string,
 			key := opt.paramsProvider(params)
 			switch key {
 			case assigneeIDsKey:
-				fields.AssigneeIds = strings.Split(params.Get(key), arraySeparator)
+				fields.AssigneeIds = splitArray(params.Get(key))
 			case estimateKey:
 				fields.Estimate = params.Get(key)
 			case statusKey:
@@ -249,28 +275,27 @@ func taskAddRequest(apiKey string, endpoint string, name string,
 	return res
 }
 
-// Update existing Task based on fields and options given.
-func (t *TaskNode) Update(workspaceID string, projectID string, id string,
+// Update existing Task based on options given. Only fields set by options
+// are sent, so fields not given are left unchanged. Empty WithAssigneeIDs
+// removes every assignee.
+func (t *TaskNode) Update(workspaceID WorkspaceID, projectID ProjectID, id TaskID,
 	opts ...RequestOption) (*Task, error) {
 	endpoint := fmt.Sprintf("%s/workspaces/%s/projects/%s/tasks/%s", t.endpoint,
 		workspaceID, projectID, id)
-	res, err := put(taskUpdateRequest(t.apiKey, endpoint, opts))
+	res, err := t.requester.put(taskUpdateRequest(endpoint, opts))
 	if err != nil {
 		return nil, fmt.Errorf("put: %w", err)
 	}
 	result := new(Task)
-	if err := json.Unmarshal(res, &result); err != nil {
-		if jErr, ok := err.(*json.UnmarshalTypeError); ok {
-			return nil, fmt.Errorf("unmarshal field %v of type %v", jErr.Field, jErr.Type)
-		}
-		return nil, fmt.Errorf("json unmarshal: %w", err)
+	if err := t.requester.unmarshal(res, &result); err != nil {
+		return nil, err
 	}
 	return result, nil
 }
 
-func taskUpdateRequest(apiKey string, endpoint string, options []RequestOption) requestOptions {
+func taskUpdateRequest(endpoint string, options []RequestOption) requestOptions {
 	res := requestOptions{
-		apiKey:   apiKey,
+		resource: ResourceTask,
 		endpoint: endpoint,
 	}
 	res.params = url.Values{}
@@ -287,54 +312,60 @@ func taskUpdateRequest(apiKey string, endpoint string, options []RequestOption)
 			key := opt.paramsProvider(params)
 			switch key {
 			case nameKey:
-				fields.Name = params.Get(key)
+				val := params.Get(key)
+				fields.Name = &val
 			case assigneeIDsKey:
-				fields.AssigneeIds = strings.Split(params.Get(key), arraySeparator)
+				val := splitArray(params.Get(key))
+				fields.AssigneeIds = &val
 			case estimateKey:
-				fields.Estimate = params.Get(key)
+				val := params.Get(key)
+				fields.Estimate = &val
 			case billableKey:
 				val, _ := strconv.ParseBool(params.Get(key))
 				fields.Billable = &val
 			case statusKey:
-				fields.Status = params.Get(key)
+				val := params.Get(key)
+				fields.Status = &val
 			}
 
 		}
 	}
+	res.fields = withExtraFields(fields, res.params)
 	res.params.Del(nameKey)
 	res.params.Del(assigneeIDsKey)
 	res.params.Del(estimateKey)
 	res.params.Del(billableKey)
 	res.params.Del(statusKey)
-	res.fields = fields
+	res.params.Del(extraFieldsKey)
 
 	injectContext(&res, options)
 
 	return res
 }
 
-// Delete existing Task.
-func (t *TaskNode) Delete(workspaceID string, projectID string, id string,
+// Delete existing Task, and return the deleted Task. Nil Task is returned when
+// Clockify respond without body.
+func (t *TaskNode) Delete(workspaceID WorkspaceID, projectID ProjectID, id TaskID,
 	opts ...RequestOption) (*Task, error) {
 	endpoint := fmt.Sprintf("%s/workspaces/%s/projects/%s/task/%s", t.endpoint,
 		workspaceID, projectID, id)
-	res, err := del(taskDeleteRequest(t.apiKey, endpoint, opts))
+	res, err := t.requester.del(taskDeleteRequest(endpoint, opts))
 	if err != nil {
 		return nil, fmt.Errorf("del: %w", err)
 	}
+	if emptyBody(res) {
+		return nil, nil
+	}
 	result := new(Task)
-	if err := json.Unmarshal(res, &result); err != nil {
-		if jErr, ok := err.(*json.UnmarshalTypeError); ok {
-			return nil, fmt.Errorf("unmarshal field %v of type %v", jErr.Field, jErr.Type)
-		}
-		return nil, fmt.Errorf("json unmarshal: %w", err)
+	if err := t.requester.unmarshal(res, &result); err != nil {
+		return nil, err
 	}
 	return result, nil
 }
 
-func taskDeleteRequest(apiKey string, endpoint string, options []RequestOption) requestOptions {
+func taskDeleteRequest(endpoint string, options []RequestOption) requestOptions {
 	res := requestOptions{
-		apiKey:   apiKey,
+		resource: ResourceTask,
 		endpoint: endpoint,
 	}
 	injectContext(&res, options)
@@ -0,0 +1,169 @@
+package glockify
+
+import (
+	"fmt"
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/suite"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+type TaskTestSuite struct {
+	suite.Suite
+	server    taskMockServer
+	testIndex int
+}
+
+type taskMockServer struct {
+	baseServer *httptest.Server
+}
+
+func (s *TaskTestSuite) SetupTest() {
+	testMux := mux.NewRouter()
+
+	testMux.HandleFunc("/workspaces/{workspaceID}/projects/{projectID}/tasks/{taskID}",
+		s.update()).Methods("PUT")
+
+	testMux.HandleFunc("/workspaces/{workspaceID}/projects/{projectID}/task/{taskID}",
+		s.delete()).Methods("DELETE")
+
+	s.server = taskMockServer{
+		baseServer: newJSONServer(testMux),
+	}
+}
+
+func (s *TaskTestSuite) TearDownTest() {
+	s.server.baseServer.Close()
+}
+
+var testsTaskUpdate = []struct {
+	name        string
+	workspaceID string
+	projectID   string
+	taskID      string
+	options     []RequestOption
+	wantBody    string
+}{
+	{
+		name:        "No Options",
+		workspaceID: "Workspace1",
+		projectID:   "Project1",
+		taskID:      "1",
+		wantBody:    `{}`,
+	},
+	{
+		name:        "Set options",
+		workspaceID: "Workspace1",
+		projectID:   "Project1",
+		taskID:      "2",
+		options: []RequestOption{
+			WithAssigneeIDs(UserIDs("User1", "User2")),
+			WithStatus(TaskStatusDone),
+		},
+		wantBody: `{"assigneeIds":["User1","User2"],"status":"DONE"}`,
+	},
+	{
+		name:        "Clear assignees",
+		workspaceID: "Workspace1",
+		projectID:   "Project1",
+		taskID:      "3",
+		options: []RequestOption{
+			WithAssigneeIDs(nil),
+		},
+		wantBody: `{"assigneeIds":[]}`,
+	},
+	{
+		name:        "Project task options",
+		workspaceID: "Workspace1",
+		projectID:   "Project1",
+		taskID:      "4",
+		options: Project{Tasks: []Task{{
+			ID:          "4",
+			Name:        "Task4",
+			ProjectID:   "Project1",
+			AssigneeIds: []UserID{"User1"},
+			Estimate:    Duration(2 * time.Hour),
+			Status:      TaskStatusActive,
+			Billable:    true,
+		}}}.Tasks[0].UpdateOptions(),
+		wantBody: `{"name":"Task4","assigneeIds":["User1"],"estimate":"PT2H","billable":true,` +
+			`"status":"ACTIVE"}`,
+	},
+}
+
+func (s *TaskTestSuite) update() func(http.ResponseWriter, *http.Request) {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if !checkAuthHeader(r) {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		s.Require().Equal("PUT", r.Method)
+
+		test := testsTaskUpdate[s.testIndex]
+
+		path := mux.Vars(r)
+		s.Require().Equal(test.workspaceID, path["workspaceID"])
+		s.Require().Equal(test.projectID, path["projectID"])
+		s.Require().Equal(test.taskID, path["taskID"])
+
+		body, err := ioutil.ReadAll(r.Body)
+		s.Require().Nil(err)
+		s.Require().JSONEq(test.wantBody, string(body))
+
+		w.WriteHeader(http.StatusOK)
+		_, err = fmt.Fprintf(w, `{"id":"dummy"}`)
+		s.Require().Nil(err)
+	}
+}
+
+func (s *TaskTestSuite) TestUpdate() {
+	glock := New(dummyAPIKey, WithEndpoint(Endpoint{
+		Base: s.server.baseServer.URL,
+	}))
+
+	for index, tc := range testsTaskUpdate {
+		s.Run(tc.name, func() {
+			s.testIndex = index
+			_, err := glock.Task.Update(WorkspaceID(tc.workspaceID), ProjectID(tc.projectID),
+				TaskID(tc.taskID), tc.options...)
+			s.Require().Nil(err)
+		})
+	}
+}
+
+// delete respond with deleted Task for Task1, and without body otherwise.
+func (s *TaskTestSuite) delete() func(http.ResponseWriter, *http.Request) {
+	return func(w http.ResponseWriter, r *http.Request) {
+		s.Require().True(checkAuthHeader(r))
+		id := mux.Vars(r)["taskID"]
+		if id != "Task1" {
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+		_, err := fmt.Fprintf(w, `{"id":%q}`, id)
+		s.Require().Nil(err)
+	}
+}
+
+func (s *TaskTestSuite) TestDelete() {
+	glock := New(dummyAPIKey, WithEndpoint(Endpoint{
+		Base: s.server.baseServer.URL,
+	}))
+
+	for _, id := range []string{"Task1", "Task2"} {
+		task, err := glock.Task.Delete("Workspace1", "Project1", TaskID(id))
+		s.Require().Nil(err)
+		if id == "Task1" {
+			s.Require().Equal(TaskID(id), task.ID)
+		} else {
+			s.Require().Nil(task)
+		}
+	}
+}
+
+func TestTaskNode(t *testing.T) {
+	suite.Run(t, &TaskTestSuite{})
+}
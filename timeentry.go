@@ -0,0 +1,416 @@
+package glockify
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strconv"
+	"time"
+)
+
+// TimeEntryNode manipulating TimeEntry resource.
+type TimeEntryNode struct {
+	endpoint  string
+	requester *requester
+}
+
+// TimeEntry represent Clockify's time entry resource. Project, Task and Tags
+// are only filled when requested with WithHydrated.
+// See: https://clockify.me/developers-api#tag-Time-entry
+type TimeEntry struct {
+	ID           TimeEntryID  `json:"id,omitempty"`
+	Description  string       `json:"description,omitempty"`
+	TagIds       []TagID      `json:"tagIds,omitempty"`
+	UserID       UserID       `json:"userId,omitempty"`
+	Billable     bool         `json:"billable,omitempty"`
+	TaskID       TaskID       `json:"taskId,omitempty"`
+	ProjectID    ProjectID    `json:"projectId,omitempty"`
+	WorkspaceID  WorkspaceID  `json:"workspaceId,omitempty"`
+	TimeInterval TimeInterval `json:"timeInterval"`
+	IsLocked     bool         `json:"isLocked,omitempty"`
+	HourlyRate   Money        `json:"hourlyRate,omitempty"`
+	CostRate     Money        `json:"costRate,omitempty"`
+	Project      *Project     `json:"project,omitempty"`
+	Task         *Task        `json:"task,omitempty"`
+	Tags         []Tag        `json:"tags,omitempty"`
+
+	// Extra holds JSON properties unknown to this package.
+	// See: WithPreserveUnknownFields.
+	Extra map[string]json.RawMessage `json:"-"`
+}
+
+// UpdateOptions return options that set every updatable field of
+// TimeEntryNode.Update to the value of t. As Clockify replaces the whole entry,
+// give them before options changing it, eg:
+// g.TimeEntry.Update(workspaceID, t.ID, t.TimeInterval.Start,
+// append(t.UpdateOptions(), WithDescription("Meeting"))...).
+func (t TimeEntry) UpdateOptions() []RequestOption {
+	opts := []RequestOption{
+		WithDescription(t.Description),
+		WithBillable(t.Billable),
+	}
+	if t.TimeInterval.End != nil {
+		opts = append(opts, WithEnd(*t.TimeInterval.End))
+	}
+	if t.ProjectID != "" {
+		opts = append(opts, WithProjectID(t.ProjectID))
+	}
+	if t.TaskID != "" {
+		opts = append(opts, WithTaskID(t.TaskID))
+	}
+	if len(t.TagIds) > 0 {
+		opts = append(opts, WithTagIDs(t.TagIds))
+	}
+	if len(t.Extra) > 0 {
+		opts = append(opts, WithExtraFields(t.Extra))
+	}
+	return opts
+}
+
+// TimeInterval is period of TimeEntry. End is nil while the entry is running.
+type TimeInterval struct {
+	Start    time.Time  `json:"start"`
+	End      *time.Time `json:"end,omitempty"`
+	Duration Duration   `json:"duration,omitempty"`
+}
+
+// Running report whether time entry has no end yet.
+func (t TimeInterval) Running() bool {
+	return t.End == nil
+}
+
+// timeFormat is format of time accepted by Clockify.
+const timeFormat = "2006-01-02T15:04:05Z"
+
+func formatTime(t time.Time) string {
+	return t.UTC().Format(timeFormat)
+}
+
+const (
+	descriptionKey = "description"
+	startKey       = "start"
+	endKey         = "end"
+	projectKey     = "project"
+	taskKey        = "task"
+	tagsKey        = "tags"
+	inProgressKey  = "in-progress"
+)
+
+// WithDescription when applied to TimeEntryNode.All, filter time entries by
+// description. Otherwise it's set time entry's description.
+func WithDescription(description string) RequestOption {
+	return RequestOption{
+		paramsProvider: func(v url.Values) string {
+			v.Set(descriptionKey, description)
+			return descriptionKey
+		},
+	}
+}
+
+// WithStart when applied to TimeEntryNode.All, filter time entries started
+// after start. Otherwise it's set time entry's start.
+func WithStart(start time.Time) RequestOption {
+	return RequestOption{
+		paramsProvider: func(v url.Values) string {
+			v.Set(startKey, formatTime(start))
+			return startKey
+		},
+	}
+}
+
+// WithEnd when applied to TimeEntryNode.All, filter time entries ended before
+// end. Otherwise it's set time entry's end.
+func WithEnd(end time.Time) RequestOption {
+	return RequestOption{
+		paramsProvider: func(v url.Values) string {
+			v.Set(endKey, formatTime(end))
+			return endKey
+		},
+	}
+}
+
+// WithProjectID when applied to TimeEntryNode.All, filter time entries by
+// project. Otherwise it's set time entry's project, empty id removes project.
+func WithProjectID(id ProjectID) RequestOption {
+	return RequestOption{
+		paramsProvider: func(v url.Values) string {
+			v.Set(projectKey, string(id))
+			return projectKey
+		},
+	}
+}
+
+// WithTaskID when applied to TimeEntryNode.All, filter time entries by task.
+// Otherwise it's set time entry's task, empty id removes task.
+func WithTaskID(id TaskID) RequestOption {
+	return RequestOption{
+		paramsProvider: func(v url.Values) string {
+			v.Set(taskKey, string(id))
+			return taskKey
+		},
+	}
+}
+
+// WithTagIDs when applied to TimeEntryNode.All, filter time entries by tags.
+// Otherwise it's set time entry's tags, nil ids removes every tag.
+func WithTagIDs(ids []TagID) RequestOption {
+	return RequestOption{
+		paramsProvider: func(v url.Values) string {
+			for _, id := range ids {
+				v.Add(tagsKey, string(id))
+			}
+			return tagsKey
+		},
+	}
+}
+
+// WithInProgress if set to true, only running time entry is returned.
+func WithInProgress(inProgress bool) RequestOption {
+	return RequestOption{
+		paramsProvider: func(v url.Values) string {
+			v.Set(inProgressKey, strconv.FormatBool(inProgress))
+			return inProgressKey
+		},
+	}
+}
+
+type timeEntryAddFields struct {
+	Start       string   `json:"start"`
+	End         string   `json:"end,omitempty"`
+	Billable    *bool    `json:"billable,omitempty"`
+	Description string   `json:"description,omitempty"`
+	ProjectID   string   `json:"projectId,omitempty"`
+	TaskID      string   `json:"taskId,omitempty"`
+	TagIds      []string `json:"tagIds,omitempty"`
+}
+
+type timeEntryUpdateFields struct {
+	Start       string    `json:"start"`
+	End         *string   `json:"end,omitempty"`
+	Billable    *bool     `json:"billable,omitempty"`
+	Description *string   `json:"description,omitempty"`
+	ProjectID   *string   `json:"projectId,omitempty"`
+	TaskID      *string   `json:"taskId,omitempty"`
+	TagIds      *[]string `json:"tagIds,omitempty"`
+}
+
+// All get all TimeEntry of user based on filter given.
+func (t *TimeEntryNode) All(workspaceID WorkspaceID, userID UserID,
+	opts ...RequestOption) ([]TimeEntry, error) {
+	endpoint := fmt.Sprintf("%s/workspaces/%s/user/%s/time-entries", t.endpoint, workspaceID,
+		userID)
+	res, err := t.requester.get(timeEntryAllRequest(endpoint, opts))
+	if err != nil {
+		return nil, fmt.Errorf("get: %w", err)
+	}
+	result := make([]TimeEntry, 0)
+	if err := t.requester.unmarshal(res, &result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func timeEntryAllRequest(endpoint string, options []RequestOption) requestOptions {
+	res := requestOptions{
+		resource:  ResourceTimeEntry,
+		operation: OperationList,
+		endpoint:  endpoint,
+	}
+	res.params = url.Values{}
+	res.params.Add(pageKey, strconv.Itoa(defaultPage))
+	res.params.Add(pageSizeKey, strconv.Itoa(defaultPageSize))
+	for _, opt := range options {
+		if opt.paramsProvider != nil {
+			opt.paramsProvider(res.params)
+		}
+	}
+	injectContext(&res, options)
+
+	return res
+}
+
+// Get one TimeEntry by its id.
+func (t *TimeEntryNode) Get(workspaceID WorkspaceID, id TimeEntryID,
+	opts ...RequestOption) (*TimeEntry, error) {
+	endpoint := fmt.Sprintf("%s/workspaces/%s/time-entries/%s", t.endpoint, workspaceID, id)
+	res, err := t.requester.get(timeEntryGetRequest(endpoint, opts))
+	if err != nil {
+		return nil, fmt.Errorf("get: %w", err)
+	}
+	result := new(TimeEntry)
+	if err := t.requester.unmarshal(res, &result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func timeEntryGetRequest(endpoint string, options []RequestOption) requestOptions {
+	res := requestOptions{
+		resource: ResourceTimeEntry,
+		endpoint: endpoint,
+	}
+	res.params = url.Values{}
+	for _, opt := range options {
+		if opt.paramsProvider != nil {
+			params := url.Values{}
+			if key := opt.paramsProvider(params); key == hydratedKey {
+				res.params.Set(key, params.Get(key))
+			}
+		}
+	}
+	injectContext(&res, options)
+
+	return res
+}
+
+// Add create new TimeEntry started at start, based on options given. Time
+// entry without WithEnd is running.
+func (t *TimeEntryNode) Add(workspaceID WorkspaceID, start time.Time,
+	opts ...RequestOption) (*TimeEntry, error) {
+	endpoint := fmt.Sprintf("%s/workspaces/%s/time-entries", t.endpoint, workspaceID)
+	res, err := t.requester.post(timeEntryAddRequest(endpoint, start, opts))
+	if err != nil {
+		return nil, fmt.Errorf("post: %w", err)
+	}
+	result := new(TimeEntry)
+	if err := t.requester.unmarshal(res, &result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func timeEntryAddRequest(endpoint string, start time.Time,
+	options []RequestOption) requestOptions {
+	res := requestOptions{
+		resource: ResourceTimeEntry,
+		endpoint: endpoint,
+	}
+
+	fields := timeEntryAddFields{Start: formatTime(start)}
+	for _, opt := range options {
+		if opt.paramsProvider != nil {
+			params := url.Values{}
+			key := opt.paramsProvider(params)
+			switch key {
+			case startKey:
+				fields.Start = params.Get(key)
+			case endKey:
+				fields.End = params.Get(key)
+			case billableKey:
+				val, _ := strconv.ParseBool(params.Get(key))
+				fields.Billable = &val
+			case descriptionKey:
+				fields.Description = params.Get(key)
+			case projectKey:
+				fields.ProjectID = params.Get(key)
+			case taskKey:
+				fields.TaskID = params.Get(key)
+			case tagsKey:
+				fields.TagIds = params[key]
+			}
+		}
+	}
+	res.fields = fields
+	injectContext(&res, options)
+
+	return res
+}
+
+// Update existing TimeEntry based on options given. Clockify replaces the whole
+// TimeEntry with fields sent, so fields not given are cleared rather than left
+// unchanged, eg: omitting WithProjectID removes the project. Use
+// TimeEntry.UpdateOptions to keep fields not changed. Start is required, and
+// WithStart is ignored.
+func (t *TimeEntryNode) Update(workspaceID WorkspaceID, id TimeEntryID, start time.Time,
+	opts ...RequestOption) (*TimeEntry, error) {
+	endpoint := fmt.Sprintf("%s/workspaces/%s/time-entries/%s", t.endpoint, workspaceID, id)
+	res, err := t.requester.put(timeEntryUpdateRequest(endpoint, start, opts))
+	if err != nil {
+		return nil, fmt.Errorf("put: %w", err)
+	}
+	result := new(TimeEntry)
+	if err := t.requester.unmarshal(res, &result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func timeEntryUpdateRequest(endpoint string, start time.Time,
+	options []RequestOption) requestOptions {
+	res := requestOptions{
+		resource: ResourceTimeEntry,
+		endpoint: endpoint,
+	}
+	res.params = url.Values{}
+	for _, opt := range options {
+		if opt.paramsProvider != nil {
+			opt.paramsProvider(res.params)
+		}
+	}
+
+	fields := timeEntryUpdateFields{Start: formatTime(start)}
+	for _, opt := range options {
+		if opt.paramsProvider != nil {
+			params := url.Values{}
+			key := opt.paramsProvider(params)
+			switch key {
+			case endKey:
+				val := params.Get(key)
+				fields.End = &val
+			case billableKey:
+				val, _ := strconv.ParseBool(params.Get(key))
+				fields.Billable = &val
+			case descriptionKey:
+				val := params.Get(key)
+				fields.Description = &val
+			case projectKey:
+				val := params.Get(key)
+				fields.ProjectID = &val
+			case taskKey:
+				val := params.Get(key)
+				fields.TaskID = &val
+			case tagsKey:
+				val := params[key]
+				if val == nil {
+					val = []string{}
+				}
+				fields.TagIds = &val
+			}
+		}
+	}
+	res.fields = withExtraFields(fields, res.params)
+	res.params = nil
+
+	injectContext(&res, options)
+
+	return res
+}
+
+// Delete existing TimeEntry, and return the deleted TimeEntry. Nil TimeEntry is
+// returned when Clockify respond without body.
+func (t *TimeEntryNode) Delete(workspaceID WorkspaceID, id TimeEntryID,
+	opts ...RequestOption) (*TimeEntry, error) {
+	endpoint := fmt.Sprintf("%s/workspaces/%s/time-entries/%s", t.endpoint, workspaceID, id)
+	res, err := t.requester.del(timeEntryDeleteRequest(endpoint, opts))
+	if err != nil {
+		return nil, fmt.Errorf("del: %w", err)
+	}
+	if emptyBody(res) {
+		return nil, nil
+	}
+	result := new(TimeEntry)
+	if err := t.requester.unmarshal(res, &result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func timeEntryDeleteRequest(endpoint string, options []RequestOption) requestOptions {
+	res := requestOptions{
+		resource: ResourceTimeEntry,
+		endpoint: endpoint,
+	}
+	injectContext(&res, options)
+
+	return res
+}
@@ -0,0 +1,231 @@
+package glockify
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/suite"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+type TimeEntryTestSuite struct {
+	suite.Suite
+	server   *httptest.Server
+	wantBody string
+}
+
+func (s *TimeEntryTestSuite) SetupTest() {
+	testMux := mux.NewRouter()
+	testMux.HandleFunc("/workspaces/{workspaceID}/user/{userID}/time-entries",
+		s.all()).Methods("GET")
+	testMux.HandleFunc("/workspaces/{workspaceID}/time-entries/{id}", s.get()).Methods("GET")
+	testMux.HandleFunc("/workspaces/{workspaceID}/time-entries", s.write()).Methods("POST")
+	testMux.HandleFunc("/workspaces/{workspaceID}/time-entries/{id}", s.replace()).
+		Methods("PUT")
+	testMux.HandleFunc("/workspaces/{workspaceID}/time-entries/{id}",
+		func(w http.ResponseWriter, r *http.Request) {
+			s.Require().True(checkAuthHeader(r))
+			if mux.Vars(r)["id"] != "Entry1" {
+				w.WriteHeader(http.StatusNoContent)
+				return
+			}
+			_, err := fmt.Fprint(w, dummyTimeEntry)
+			s.Require().Nil(err)
+		}).Methods("DELETE")
+
+	s.server = newJSONServer(testMux)
+}
+
+func (s *TimeEntryTestSuite) TearDownTest() {
+	s.server.Close()
+}
+
+const dummyTimeEntry = `{"id":"Entry1","description":"Work","userId":"User1",` +
+	`"projectId":"Project1","workspaceId":"Workspace1","tagIds":["Tag1"],` +
+	`"timeInterval":{"start":"2021-01-02T08:00:00Z","end":"2021-01-02T09:30:00Z",` +
+	`"duration":"PT1H30M"}}`
+
+func (s *TimeEntryTestSuite) all() func(http.ResponseWriter, *http.Request) {
+	return func(w http.ResponseWriter, r *http.Request) {
+		s.Require().True(checkAuthHeader(r))
+		path := mux.Vars(r)
+		s.Require().Equal("Workspace1", path["workspaceID"])
+		s.Require().Equal("User1", path["userID"])
+
+		query := r.URL.Query()
+		s.Require().Equal("2021-01-01T00:00:00Z", query.Get("start"))
+		s.Require().Equal("2021-01-31T00:00:00Z", query.Get("end"))
+		s.Require().Equal("Project1", query.Get("project"))
+		s.Require().Equal([]string{"Tag1", "Tag2"}, query["tags"])
+		s.Require().Equal("Work", query.Get("description"))
+		s.Require().Equal("1", query.Get("page"))
+
+		_, err := fmt.Fprintf(w, "[%s]", dummyTimeEntry)
+		s.Require().Nil(err)
+	}
+}
+
+func (s *TimeEntryTestSuite) get() func(http.ResponseWriter, *http.Request) {
+	return func(w http.ResponseWriter, r *http.Request) {
+		s.Require().True(checkAuthHeader(r))
+		s.Require().Equal("true", r.URL.Query().Get("hydrated"))
+		_, err := fmt.Fprintf(w, `{"id":%q,"timeInterval":{"start":"2021-01-02T08:00:00Z",`+
+			`"end":null},"project":{"id":"Project1","name":"Project"},`+
+			`"tags":[{"id":"Tag1","name":"Tag"}]}`, mux.Vars(r)["id"])
+		s.Require().Nil(err)
+	}
+}
+
+func (s *TimeEntryTestSuite) write() func(http.ResponseWriter, *http.Request) {
+	return func(w http.ResponseWriter, r *http.Request) {
+		s.Require().True(checkAuthHeader(r))
+		body, err := ioutil.ReadAll(r.Body)
+		s.Require().Nil(err)
+		s.Require().JSONEq(s.wantBody, string(body))
+
+		if r.Method == http.MethodPost {
+			w.WriteHeader(http.StatusCreated)
+		}
+		_, err = fmt.Fprint(w, dummyTimeEntry)
+		s.Require().Nil(err)
+	}
+}
+
+// replace mock Clockify's PUT, which replaces the whole entry with fields sent.
+func (s *TimeEntryTestSuite) replace() func(http.ResponseWriter, *http.Request) {
+	return func(w http.ResponseWriter, r *http.Request) {
+		s.Require().True(checkAuthHeader(r))
+		body, err := ioutil.ReadAll(r.Body)
+		s.Require().Nil(err)
+		s.Require().JSONEq(s.wantBody, string(body))
+
+		fields := timeEntryAddFields{}
+		s.Require().Nil(json.Unmarshal(body, &fields))
+		s.Require().NotEmpty(fields.Start)
+		s.Require().Nil(json.NewEncoder(w).Encode(map[string]interface{}{
+			"id":          mux.Vars(r)["id"],
+			"description": fields.Description,
+			"projectId":   fields.ProjectID,
+			"tagIds":      fields.TagIds,
+			"timeInterval": map[string]interface{}{
+				"start": fields.Start,
+			},
+		}))
+	}
+}
+
+func (s *TimeEntryTestSuite) glock() *Glockify {
+	return New(dummyAPIKey, WithEndpoint(Endpoint{Base: s.server.URL}))
+}
+
+func (s *TimeEntryTestSuite) TestAll() {
+	entries, err := s.glock().TimeEntry.All("Workspace1", "User1",
+		WithStart(time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)),
+		WithEnd(time.Date(2021, 1, 31, 7, 0, 0, 0, time.FixedZone("WIB", 7*60*60))),
+		WithProjectID("Project1"),
+		WithTagIDs(TagIDs("Tag1", "Tag2")),
+		WithDescription("Work"),
+	)
+	s.Require().Nil(err)
+	s.Require().Len(entries, 1)
+
+	entry := entries[0]
+	s.Require().Equal(TimeEntryID("Entry1"), entry.ID)
+	s.Require().Equal([]TagID{"Tag1"}, entry.TagIds)
+	s.Require().Equal(time.Date(2021, 1, 2, 8, 0, 0, 0, time.UTC), entry.TimeInterval.Start)
+	s.Require().False(entry.TimeInterval.Running())
+	s.Require().Equal(time.Date(2021, 1, 2, 9, 30, 0, 0, time.UTC), *entry.TimeInterval.End)
+	s.Require().Equal(90*time.Minute, entry.TimeInterval.Duration.TimeDuration())
+}
+
+func (s *TimeEntryTestSuite) TestGet() {
+	entry, err := s.glock().TimeEntry.Get("Workspace1", "Entry2", WithHydrated(true),
+		WithPage(2))
+	s.Require().Nil(err)
+	s.Require().Equal(TimeEntryID("Entry2"), entry.ID)
+	s.Require().True(entry.TimeInterval.Running())
+	s.Require().Equal("Project", entry.Project.Name)
+	s.Require().Equal("Tag", entry.Tags[0].Name)
+}
+
+func (s *TimeEntryTestSuite) TestAdd() {
+	s.wantBody = `{"start":"2021-01-02T08:00:00Z","end":"2021-01-02T09:30:00Z",` +
+		`"billable":true,"description":"Work","projectId":"Project1","taskId":"Task1",` +
+		`"tagIds":["Tag1"]}`
+	_, err := s.glock().TimeEntry.Add("Workspace1", time.Date(2021, 1, 2, 8, 0, 0, 0, time.UTC),
+		WithEnd(time.Date(2021, 1, 2, 9, 30, 0, 0, time.UTC)),
+		WithBillable(true),
+		WithDescription("Work"),
+		WithProjectID("Project1"),
+		WithTaskID("Task1"),
+		WithTagIDs([]TagID{"Tag1"}),
+	)
+	s.Require().Nil(err)
+}
+
+var testsTimeEntryUpdate = []struct {
+	name      string
+	options   []RequestOption
+	wantBody  string
+	wantStart time.Time
+}{
+	{
+		name:      "Clear Fields",
+		options:   []RequestOption{WithDescription(""), WithTagIDs(nil)},
+		wantBody:  `{"start":"2021-01-02T08:00:00Z","description":"","tagIds":[]}`,
+		wantStart: time.Date(2021, 1, 2, 8, 0, 0, 0, time.UTC),
+	},
+	{
+		name: "Ignore WithStart",
+		options: []RequestOption{WithStart(time.Date(2021, 1, 3, 8, 0, 0, 0, time.UTC)),
+			WithDescription("Work")},
+		wantBody:  `{"start":"2021-01-02T08:00:00Z","description":"Work"}`,
+		wantStart: time.Date(2021, 1, 2, 8, 0, 0, 0, time.UTC),
+	},
+}
+
+func (s *TimeEntryTestSuite) TestUpdate() {
+	for _, tc := range testsTimeEntryUpdate {
+		s.Run(tc.name, func() {
+			s.wantBody = tc.wantBody
+			entry, err := s.glock().TimeEntry.Update("Workspace1", "Entry1",
+				time.Date(2021, 1, 2, 8, 0, 0, 0, time.UTC), tc.options...)
+			s.Require().Nil(err)
+			s.Require().Equal(tc.wantStart, entry.TimeInterval.Start)
+			// Project not given is cleared by Clockify.
+			s.Require().Empty(entry.ProjectID)
+		})
+	}
+}
+
+func (s *TimeEntryTestSuite) TestUpdateOptions() {
+	entry := TimeEntry{}
+	s.Require().Nil(json.Unmarshal([]byte(dummyTimeEntry), &entry))
+
+	s.wantBody = `{"start":"2021-01-02T08:00:00Z","end":"2021-01-02T09:30:00Z",` +
+		`"billable":false,"description":"Meeting","projectId":"Project1","tagIds":["Tag1"]}`
+	updated, err := s.glock().TimeEntry.Update("Workspace1", entry.ID, entry.TimeInterval.Start,
+		append(entry.UpdateOptions(), WithDescription("Meeting"))...)
+	s.Require().Nil(err)
+	s.Require().Equal("Meeting", updated.Description)
+	s.Require().Equal(entry.ProjectID, updated.ProjectID)
+	s.Require().Equal(entry.TagIds, updated.TagIds)
+}
+
+func (s *TimeEntryTestSuite) TestDelete() {
+	entry, err := s.glock().TimeEntry.Delete("Workspace1", "Entry1")
+	s.Require().Nil(err)
+	s.Require().Equal(TimeEntryID("Entry1"), entry.ID)
+
+	entry, err = s.glock().TimeEntry.Delete("Workspace1", "Entry2")
+	s.Require().Nil(err)
+	s.Require().Nil(entry)
+}
+
+func TestTimeEntryNode(t *testing.T) {
+	suite.Run(t, &TimeEntryTestSuite{})
+}
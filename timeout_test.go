@@ -0,0 +1,108 @@
+package glockify
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/suite"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+type TimeoutTestSuite struct {
+	suite.Suite
+	server *httptest.Server
+}
+
+func (s *TimeoutTestSuite) SetupTest() {
+	testMux := mux.NewRouter()
+
+	testMux.HandleFunc("/workspaces/{workspaceID}/clients", func(w http.ResponseWriter,
+		r *http.Request) {
+		time.Sleep(50 * time.Millisecond)
+		w.WriteHeader(http.StatusOK)
+		_, _ = fmt.Fprintf(w, `[]`)
+	})
+	testMux.HandleFunc("/workspaces/{workspaceID}/clients/{clientID}", func(w http.ResponseWriter,
+		r *http.Request) {
+		time.Sleep(50 * time.Millisecond)
+		w.WriteHeader(http.StatusOK)
+		_, _ = fmt.Fprintf(w, `{}`)
+	})
+
+	s.server = newJSONServer(testMux)
+}
+
+func (s *TimeoutTestSuite) TearDownTest() {
+	s.server.Close()
+}
+
+var testsTimeout = []struct {
+	name    string
+	list    bool
+	options []RequestOption
+	wantErr bool
+}{
+	{
+		name:    "List Default Timeout",
+		list:    true,
+		wantErr: true,
+	},
+	{
+		name:    "List Override Timeout",
+		list:    true,
+		options: []RequestOption{WithTimeout(time.Second)},
+		wantErr: false,
+	},
+	{
+		name:    "List Disable Timeout",
+		list:    true,
+		options: []RequestOption{WithTimeout(NoTimeout)},
+		wantErr: false,
+	},
+	{
+		name:    "List Zero Timeout Keep Default",
+		list:    true,
+		options: []RequestOption{WithTimeout(0)},
+		wantErr: true,
+	},
+	{
+		name:    "Read Without Timeout",
+		wantErr: false,
+	},
+	{
+		name:    "Read Override Timeout",
+		options: []RequestOption{WithTimeout(time.Millisecond)},
+		wantErr: true,
+	},
+}
+
+func (s *TimeoutTestSuite) TestTimeout() {
+	glock := New(dummyAPIKey, WithDefaultTimeout(OperationList, 10*time.Millisecond),
+		WithEndpoint(Endpoint{
+			Base: s.server.URL,
+		}))
+
+	for _, tc := range testsTimeout {
+		s.Run(tc.name, func() {
+			var err error
+			if tc.list {
+				_, err = glock.Client.All("Workspace1", tc.options...)
+			} else {
+				_, err = glock.Client.Get("Workspace1", "1", tc.options...)
+			}
+			if tc.wantErr {
+				s.Require().True(errors.Is(err, context.DeadlineExceeded))
+			} else {
+				s.Require().Nil(err)
+			}
+		})
+	}
+}
+
+func TestTimeout(t *testing.T) {
+	suite.Run(t, &TimeoutTestSuite{})
+}
@@ -0,0 +1,150 @@
+package glockify
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+// ErrNoRunningTimer match every NoRunningTimerError with errors.Is.
+var ErrNoRunningTimer = errors.New("no running timer")
+
+// NoRunningTimerError returned when user has no running timer to get or stop.
+type NoRunningTimerError struct {
+	WorkspaceID WorkspaceID
+	UserID      UserID
+}
+
+func (e *NoRunningTimerError) Error() string {
+	return fmt.Sprintf("%v: user %s in workspace %s", ErrNoRunningTimer, e.UserID,
+		e.WorkspaceID)
+}
+
+// Is report whether target is ErrNoRunningTimer.
+func (e *NoRunningTimerError) Is(target error) bool {
+	return target == ErrNoRunningTimer
+}
+
+// RunningTimer is time entry which hasn't ended yet.
+type RunningTimer struct {
+	ID          TimeEntryID
+	WorkspaceID WorkspaceID
+	UserID      UserID
+	Description string
+	ProjectID   ProjectID
+	TaskID      TaskID
+	TagIds      []TagID
+	Billable    bool
+	Start       time.Time
+}
+
+func newRunningTimer(entry TimeEntry) *RunningTimer {
+	return &RunningTimer{
+		ID:          entry.ID,
+		WorkspaceID: entry.WorkspaceID,
+		UserID:      entry.UserID,
+		Description: entry.Description,
+		ProjectID:   entry.ProjectID,
+		TaskID:      entry.TaskID,
+		TagIds:      entry.TagIds,
+		Billable:    entry.Billable,
+		Start:       entry.TimeInterval.Start,
+	}
+}
+
+// Elapsed return time passed since timer started.
+func (r RunningTimer) Elapsed() time.Duration {
+	return time.Since(r.Start)
+}
+
+type timerStopFields struct {
+	End string `json:"end"`
+}
+
+// StartTimer start new timer of current user, based on options such as
+// WithProjectID, WithTaskID, WithDescription, WithTagIDs and WithBillable.
+// Timer start now, unless WithStart given. WithEnd is ignored.
+func (g *Glockify) StartTimer(workspaceID WorkspaceID, opts ...RequestOption) (*RunningTimer,
+	error) {
+	endpoint := fmt.Sprintf("%s/workspaces/%s/time-entries", g.endpoint.Base, workspaceID)
+	req := timeEntryAddRequest(endpoint, time.Now(), opts)
+	fields := req.fields.(timeEntryAddFields)
+	fields.End = ""
+	req.fields = fields
+
+	res, err := g.requester.post(req)
+	if err != nil {
+		return nil, fmt.Errorf("post: %w", err)
+	}
+	result := TimeEntry{}
+	if err := g.requester.unmarshal(res, &result); err != nil {
+		return nil, err
+	}
+	return newRunningTimer(result), nil
+}
+
+// StopTimer stop running timer of user at end, and return the stopped
+// TimeEntry. It returns NoRunningTimerError when user has no running timer.
+func (g *Glockify) StopTimer(workspaceID WorkspaceID, userID UserID, end time.Time,
+	opts ...RequestOption) (*TimeEntry, error) {
+	endpoint := fmt.Sprintf("%s/workspaces/%s/user/%s/time-entries", g.endpoint.Base,
+		workspaceID, userID)
+	res, err := g.requester.patch(timerStopRequest(endpoint, end, opts))
+	if err != nil {
+		var httpErr *HTTPError
+		if errors.As(err, &httpErr) && httpErr.StatusCode == http.StatusNotFound {
+			return nil, &NoRunningTimerError{WorkspaceID: workspaceID, UserID: userID}
+		}
+		return nil, fmt.Errorf("patch: %w", err)
+	}
+	result := new(TimeEntry)
+	if err := g.requester.unmarshal(res, &result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func timerStopRequest(endpoint string, end time.Time, options []RequestOption) requestOptions {
+	res := requestOptions{
+		resource: ResourceTimeEntry,
+		endpoint: endpoint,
+	}
+	res.fields = timerStopFields{End: formatTime(end)}
+	injectContext(&res, options)
+
+	return res
+}
+
+// CurrentTimer get running timer of user. It returns NoRunningTimerError when
+// user has no running timer.
+func (g *Glockify) CurrentTimer(workspaceID WorkspaceID, userID UserID,
+	opts ...RequestOption) (*RunningTimer, error) {
+	endpoint := fmt.Sprintf("%s/workspaces/%s/user/%s/time-entries", g.endpoint.Base,
+		workspaceID, userID)
+	res, err := g.requester.get(timerCurrentRequest(endpoint, opts))
+	if err != nil {
+		return nil, fmt.Errorf("get: %w", err)
+	}
+	result := make([]TimeEntry, 0)
+	if err := g.requester.unmarshal(res, &result); err != nil {
+		return nil, err
+	}
+	if len(result) == 0 {
+		return nil, &NoRunningTimerError{WorkspaceID: workspaceID, UserID: userID}
+	}
+	return newRunningTimer(result[0]), nil
+}
+
+func timerCurrentRequest(endpoint string, options []RequestOption) requestOptions {
+	res := requestOptions{
+		resource: ResourceTimeEntry,
+		endpoint: endpoint,
+	}
+	res.params = url.Values{}
+	res.params.Set(inProgressKey, "true")
+	injectContext(&res, options)
+
+	return res
+}
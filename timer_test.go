@@ -0,0 +1,120 @@
+package glockify
+
+import (
+	"errors"
+	"fmt"
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/suite"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+type TimerTestSuite struct {
+	suite.Suite
+	server  *httptest.Server
+	running bool
+}
+
+const dummyRunningTimer = `{"id":"Entry1","description":"Work","userId":"User1",` +
+	`"projectId":"Project1","workspaceId":"Workspace1",` +
+	`"timeInterval":{"start":"2021-01-02T08:00:00Z","end":null}}`
+
+func (s *TimerTestSuite) SetupTest() {
+	s.running = true
+
+	testMux := mux.NewRouter()
+	testMux.HandleFunc("/workspaces/{workspaceID}/time-entries",
+		func(w http.ResponseWriter, r *http.Request) {
+			s.Require().True(checkAuthHeader(r))
+			body, err := ioutil.ReadAll(r.Body)
+			s.Require().Nil(err)
+			s.Require().JSONEq(`{"start":"2021-01-02T08:00:00Z","projectId":"Project1",`+
+				`"description":"Work","billable":true}`, string(body))
+			w.WriteHeader(http.StatusCreated)
+			_, err = fmt.Fprint(w, dummyRunningTimer)
+			s.Require().Nil(err)
+		}).Methods("POST")
+	testMux.HandleFunc("/workspaces/{workspaceID}/user/{userID}/time-entries",
+		func(w http.ResponseWriter, r *http.Request) {
+			s.Require().True(checkAuthHeader(r))
+			s.Require().Equal("true", r.URL.Query().Get("in-progress"))
+			if !s.running {
+				_, err := fmt.Fprint(w, `[]`)
+				s.Require().Nil(err)
+				return
+			}
+			_, err := fmt.Fprintf(w, "[%s]", dummyRunningTimer)
+			s.Require().Nil(err)
+		}).Methods("GET")
+	testMux.HandleFunc("/workspaces/{workspaceID}/user/{userID}/time-entries",
+		func(w http.ResponseWriter, r *http.Request) {
+			s.Require().True(checkAuthHeader(r))
+			if !s.running {
+				// Not found page is plain text, not JSON.
+				http.NotFound(w, r)
+				return
+			}
+			body, err := ioutil.ReadAll(r.Body)
+			s.Require().Nil(err)
+			s.Require().JSONEq(`{"end":"2021-01-02T09:00:00Z"}`, string(body))
+			_, err = fmt.Fprint(w, `{"id":"Entry1","timeInterval":{"start":"2021-01-02T08:00:00Z",`+
+				`"end":"2021-01-02T09:00:00Z","duration":"PT1H"}}`)
+			s.Require().Nil(err)
+		}).Methods("PATCH")
+
+	s.server = newJSONServer(testMux)
+}
+
+func (s *TimerTestSuite) TearDownTest() {
+	s.server.Close()
+}
+
+func (s *TimerTestSuite) glock() *Glockify {
+	return New(dummyAPIKey, WithEndpoint(Endpoint{Base: s.server.URL}))
+}
+
+func (s *TimerTestSuite) TestStart() {
+	timer, err := s.glock().StartTimer("Workspace1",
+		WithStart(time.Date(2021, 1, 2, 8, 0, 0, 0, time.UTC)),
+		WithEnd(time.Date(2021, 1, 2, 9, 0, 0, 0, time.UTC)),
+		WithProjectID("Project1"),
+		WithDescription("Work"),
+		WithBillable(true),
+	)
+	s.Require().Nil(err)
+	s.Require().Equal(TimeEntryID("Entry1"), timer.ID)
+	s.Require().Equal(ProjectID("Project1"), timer.ProjectID)
+	s.Require().Equal(time.Date(2021, 1, 2, 8, 0, 0, 0, time.UTC), timer.Start)
+}
+
+func (s *TimerTestSuite) TestCurrent() {
+	timer, err := s.glock().CurrentTimer("Workspace1", "User1")
+	s.Require().Nil(err)
+	s.Require().Equal("Work", timer.Description)
+	s.Require().True(timer.Elapsed() > 0)
+
+	s.running = false
+	_, err = s.glock().CurrentTimer("Workspace1", "User1")
+	s.Require().True(errors.Is(err, ErrNoRunningTimer))
+}
+
+func (s *TimerTestSuite) TestStop() {
+	end := time.Date(2021, 1, 2, 9, 0, 0, 0, time.UTC)
+	entry, err := s.glock().StopTimer("Workspace1", "User1", end)
+	s.Require().Nil(err)
+	s.Require().Equal(end, *entry.TimeInterval.End)
+
+	s.running = false
+	_, err = s.glock().StopTimer("Workspace1", "User1", end)
+	s.Require().True(errors.Is(err, ErrNoRunningTimer))
+	noTimer := &NoRunningTimerError{}
+	s.Require().True(errors.As(err, &noTimer))
+	s.Require().Equal(UserID("User1"), noTimer.UserID)
+}
+
+func TestTimer(t *testing.T) {
+	suite.Run(t, &TimerTestSuite{})
+}
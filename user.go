@@ -0,0 +1,268 @@
+package glockify
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strconv"
+	"time"
+)
+
+// UserNode manipulating User resource.
+type UserNode struct {
+	endpoint  string
+	requester *requester
+}
+
+// User represent Clockify's user resource.
+// See: https://clockify.me/developers-api#tag-User
+type User struct {
+	ID               UserID        `json:"id,omitempty"`
+	Email            string        `json:"email,omitempty"`
+	Name             string        `json:"name,omitempty"`
+	Memberships      []Memberships `json:"memberships,omitempty"`
+	ProfilePicture   string        `json:"profilePicture,omitempty"`
+	ActiveWorkspace  WorkspaceID   `json:"activeWorkspace,omitempty"`
+	DefaultWorkspace WorkspaceID   `json:"defaultWorkspace,omitempty"`
+	Settings         UserSettings  `json:"settings,omitempty"`
+	Status           AccountStatus `json:"status,omitempty"`
+
+	// Extra holds JSON properties unknown to this package.
+	// See: WithPreserveUnknownFields.
+	Extra map[string]json.RawMessage `json:"-"`
+}
+
+// UserSettings see: https://clockify.me/developers-api#tag-User
+type UserSettings struct {
+	WeekStart          DayOfWeek `json:"weekStart,omitempty"`
+	TimeZone           string    `json:"timeZone,omitempty"`
+	TimeFormat         string    `json:"timeFormat,omitempty"`
+	DateFormat         string    `json:"dateFormat,omitempty"`
+	SendNewsletter     bool      `json:"sendNewsletter,omitempty"`
+	WeeklyUpdates      bool      `json:"weeklyUpdates,omitempty"`
+	LongRunning        bool      `json:"longRunning,omitempty"`
+	TimeTrackingManual bool      `json:"timeTrackingManual,omitempty"`
+	IsCompactViewOn    bool      `json:"isCompactViewOn,omitempty"`
+	DashboardSelection string    `json:"dashboardSelection,omitempty"`
+	DashboardViewType  string    `json:"dashboardViewType,omitempty"`
+	DashboardPinToTop  bool      `json:"dashboardPinToTop,omitempty"`
+	MyStartOfDay       string    `json:"myStartOfDay,omitempty"`
+}
+
+// Location return time zone of user as time.Location. Empty TimeZone is UTC.
+func (s UserSettings) Location() (*time.Location, error) {
+	loc, err := time.LoadLocation(s.TimeZone)
+	if err != nil {
+		return nil, fmt.Errorf("time zone %s: %w", s.TimeZone, err)
+	}
+	return loc, nil
+}
+
+type AccountStatus string
+
+// Possible values of AccountStatus
+const (
+	AccountStatusActive                   AccountStatus = "ACTIVE"
+	AccountStatusPendingEmailVerification AccountStatus = "PENDING_EMAIL_VERIFICATION"
+	AccountStatusDeleted                  AccountStatus = "DELETED"
+	AccountStatusNotRegistered            AccountStatus = "NOT_REGISTERED"
+	AccountStatusLimited                  AccountStatus = "LIMITED"
+	AccountStatusLimitedDeleted           AccountStatus = "LIMITED_DELETED"
+	AccountStatusUnknown                  AccountStatus = enumUnknown
+)
+
+// UnmarshalJSON decode AccountStatus, unknown value decode into
+// AccountStatusUnknown.
+func (a *AccountStatus) UnmarshalJSON(data []byte) error {
+	v, err := unmarshalEnum(data, string(AccountStatusUnknown), string(AccountStatusActive),
+		string(AccountStatusPendingEmailVerification), string(AccountStatusDeleted),
+		string(AccountStatusNotRegistered), string(AccountStatusLimited),
+		string(AccountStatusLimitedDeleted))
+	*a = AccountStatus(v)
+	return err
+}
+
+const (
+	emailKey     = "email"
+	rolesKey     = "roles"
+	projectIDKey = "projectId"
+)
+
+// WithEmail filter users returned by email.
+func WithEmail(email string) RequestOption {
+	return RequestOption{
+		paramsProvider: func(v url.Values) string {
+			v.Set(emailKey, email)
+			return emailKey
+		},
+	}
+}
+
+// WithMembershipStatus filter users returned by their membership status.
+func WithMembershipStatus(status MembershipStatus) RequestOption {
+	return RequestOption{
+		paramsProvider: func(v url.Values) string {
+			v.Set(statusKey, string(status))
+			return statusKey
+		},
+	}
+}
+
+type MembershipsFilter string
+
+// Possible values of MembershipsFilter
+const (
+	MembershipsFilterNone      MembershipsFilter = "NONE"
+	MembershipsFilterAll       MembershipsFilter = "ALL"
+	MembershipsFilterWorkspace MembershipsFilter = "WORKSPACE"
+	MembershipsFilterProject   MembershipsFilter = "PROJECT"
+	MembershipsFilterUserGroup MembershipsFilter = "USERGROUP"
+)
+
+// WithUserMemberships set which memberships returned along with users.
+// Default to MembershipsFilterNone.
+func WithUserMemberships(memberships MembershipsFilter) RequestOption {
+	return RequestOption{
+		paramsProvider: func(v url.Values) string {
+			v.Set(membershipsKey, string(memberships))
+			return membershipsKey
+		},
+	}
+}
+
+type Role string
+
+// Possible values of Role
+const (
+	RoleWorkspaceAdmin Role = "WORKSPACE_ADMIN"
+	RoleOwner          Role = "OWNER"
+	RoleTeamManager    Role = "TEAM_MANAGER"
+	RoleProjectManager Role = "PROJECT_MANAGER"
+)
+
+// WithRoles filter users returned by their roles.
+func WithRoles(roles []Role) RequestOption {
+	return RequestOption{
+		paramsProvider: func(v url.Values) string {
+			for _, role := range roles {
+				v.Add(rolesKey, string(role))
+			}
+			return rolesKey
+		},
+	}
+}
+
+type UserSortColumn string
+
+const (
+	UserSortColumnEmail      UserSortColumn = "EMAIL"
+	UserSortColumnName       UserSortColumn = "NAME"
+	UserSortColumnHourlyRate UserSortColumn = "HOURLYRATE"
+)
+
+// WithUserSortColumn set fields you want to sort against.
+func WithUserSortColumn(sortColumn UserSortColumn) RequestOption {
+	return RequestOption{
+		paramsProvider: func(v url.Values) string {
+			v.Set(sortColumnKey, string(sortColumn))
+			return sortColumnKey
+		},
+	}
+}
+
+type userUpdateStatusFields struct {
+	MembershipStatus MembershipStatus `json:"membershipStatus"`
+}
+
+// Current get user owning the API key, including its settings and active
+// workspace.
+func (u *UserNode) Current(opts ...RequestOption) (*User, error) {
+	endpoint := fmt.Sprintf("%s/user", u.endpoint)
+	res, err := u.requester.get(userCurrentRequest(endpoint, opts))
+	if err != nil {
+		return nil, fmt.Errorf("get: %w", err)
+	}
+	result := new(User)
+	if err := u.requester.unmarshal(res, &result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func userCurrentRequest(endpoint string, options []RequestOption) requestOptions {
+	res := requestOptions{
+		resource: ResourceUser,
+		endpoint: endpoint,
+	}
+	res.params = url.Values{}
+	injectContext(&res, options)
+
+	return res
+}
+
+// All get all User of workspace based on filter given, eg: WithEmail,
+// WithName, WithMembershipStatus, WithProjectID and WithRoles.
+func (u *UserNode) All(workspaceID WorkspaceID, opts ...RequestOption) ([]User, error) {
+	endpoint := fmt.Sprintf("%s/workspaces/%s/users", u.endpoint, workspaceID)
+	res, err := u.requester.get(userAllRequest(endpoint, opts))
+	if err != nil {
+		return nil, fmt.Errorf("get: %w", err)
+	}
+	result := make([]User, 0)
+	if err := u.requester.unmarshal(res, &result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func userAllRequest(endpoint string, options []RequestOption) requestOptions {
+	res := requestOptions{
+		resource:  ResourceUser,
+		operation: OperationList,
+		endpoint:  endpoint,
+	}
+	res.params = url.Values{}
+	res.params.Add(pageKey, strconv.Itoa(defaultPage))
+	res.params.Add(pageSizeKey, strconv.Itoa(defaultPageSize))
+	res.params.Add(sortOrderKey, string(defaultSortOrder))
+	for _, opt := range options {
+		if opt.paramsProvider != nil {
+			opt.paramsProvider(res.params)
+		}
+	}
+	if projectID := res.params.Get(projectKey); projectID != "" {
+		res.params.Set(projectIDKey, projectID)
+	}
+	res.params.Del(projectKey)
+	injectContext(&res, options)
+
+	return res
+}
+
+// UpdateStatus set membership status of user in workspace, eg:
+// MembershipStatusInactive to deactivate the user. It returns the workspace.
+func (u *UserNode) UpdateStatus(workspaceID WorkspaceID, id UserID, status MembershipStatus,
+	opts ...RequestOption) (*Workspace, error) {
+	endpoint := fmt.Sprintf("%s/workspaces/%s/users/%s", u.endpoint, workspaceID, id)
+	res, err := u.requester.put(userUpdateStatusRequest(endpoint, status, opts))
+	if err != nil {
+		return nil, fmt.Errorf("put: %w", err)
+	}
+	result := new(Workspace)
+	if err := u.requester.unmarshal(res, &result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func userUpdateStatusRequest(endpoint string, status MembershipStatus,
+	options []RequestOption) requestOptions {
+	res := requestOptions{
+		resource: ResourceUser,
+		endpoint: endpoint,
+	}
+	res.fields = userUpdateStatusFields{MembershipStatus: status}
+	injectContext(&res, options)
+
+	return res
+}
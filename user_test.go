@@ -0,0 +1,107 @@
+package glockify
+
+import (
+	"fmt"
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/suite"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+)
+
+type UserTestSuite struct {
+	suite.Suite
+	server     *httptest.Server
+	wantParams url.Values
+}
+
+func (s *UserTestSuite) SetupTest() {
+	testMux := mux.NewRouter()
+	testMux.HandleFunc("/user", func(w http.ResponseWriter, r *http.Request) {
+		s.Require().True(checkAuthHeader(r))
+		_, err := fmt.Fprint(w, `{"id":"User1","email":"user@example.com",`+
+			`"activeWorkspace":"Workspace1","status":"ACTIVE",`+
+			`"settings":{"weekStart":"MONDAY","timeZone":"Asia/Jakarta"}}`)
+		s.Require().Nil(err)
+	}).Methods("GET")
+	testMux.HandleFunc("/workspaces/{workspaceID}/users",
+		func(w http.ResponseWriter, r *http.Request) {
+			s.Require().True(checkAuthHeader(r))
+			s.Require().Equal(s.wantParams, r.URL.Query())
+			_, err := fmt.Fprint(w, `[{"id":"User1","memberships":[{"userId":"User1",`+
+				`"membershipType":"WORKSPACE","membershipStatus":"ACTIVE"}]}]`)
+			s.Require().Nil(err)
+		}).Methods("GET")
+	testMux.HandleFunc("/workspaces/{workspaceID}/users/{userID}",
+		func(w http.ResponseWriter, r *http.Request) {
+			s.Require().True(checkAuthHeader(r))
+			s.Require().Equal("User2", mux.Vars(r)["userID"])
+			body, err := ioutil.ReadAll(r.Body)
+			s.Require().Nil(err)
+			s.Require().JSONEq(`{"membershipStatus":"INACTIVE"}`, string(body))
+			_, err = fmt.Fprint(w, `{"id":"Workspace1"}`)
+			s.Require().Nil(err)
+		}).Methods("PUT")
+
+	s.server = newJSONServer(testMux)
+}
+
+func (s *UserTestSuite) TearDownTest() {
+	s.server.Close()
+}
+
+func (s *UserTestSuite) glock() *Glockify {
+	return New(dummyAPIKey, WithEndpoint(Endpoint{Base: s.server.URL}))
+}
+
+func (s *UserTestSuite) TestCurrent() {
+	user, err := s.glock().User.Current()
+	s.Require().Nil(err)
+	s.Require().Equal(UserID("User1"), user.ID)
+	s.Require().Equal(WorkspaceID("Workspace1"), user.ActiveWorkspace)
+	s.Require().Equal(AccountStatusActive, user.Status)
+	s.Require().Equal(DayOfWeekMonday, user.Settings.WeekStart)
+
+	loc, err := user.Settings.Location()
+	s.Require().Nil(err)
+	s.Require().Equal("Asia/Jakarta", loc.String())
+}
+
+func (s *UserTestSuite) TestAll() {
+	s.wantParams = map[string][]string{
+		"email":       {"user@example.com"},
+		"memberships": {"WORKSPACE"},
+		"name":        {"User"},
+		"page":        {"1"},
+		"page-size":   {"50"},
+		"projectId":   {"Project1"},
+		"roles":       {"OWNER", "TEAM_MANAGER"},
+		"sort-column": {"EMAIL"},
+		"sort-order":  {"DESCENDING"},
+		"status":      {"ACTIVE"},
+	}
+	users, err := s.glock().User.All("Workspace1",
+		WithEmail("user@example.com"),
+		WithName("User"),
+		WithMembershipStatus(MembershipStatusActive),
+		WithUserMemberships(MembershipsFilterWorkspace),
+		WithProjectID("Project1"),
+		WithRoles([]Role{RoleOwner, RoleTeamManager}),
+		WithUserSortColumn(UserSortColumnEmail),
+	)
+	s.Require().Nil(err)
+	s.Require().Equal(MembershipTypeWorkspace, users[0].Memberships[0].MembershipType)
+}
+
+func (s *UserTestSuite) TestUpdateStatus() {
+	workspace, err := s.glock().User.UpdateStatus("Workspace1", "User2",
+		MembershipStatusInactive)
+	s.Require().Nil(err)
+	s.Require().Equal(WorkspaceID("Workspace1"), workspace.ID)
+}
+
+func TestUserNode(t *testing.T) {
+	suite.Run(t, &UserTestSuite{})
+}
@@ -0,0 +1,258 @@
+package glockify
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strconv"
+)
+
+// UserGroupNode manipulating UserGroup resource.
+type UserGroupNode struct {
+	endpoint  string
+	requester *requester
+}
+
+// UserGroup represent Clockify's user group resource.
+// See: https://clockify.me/developers-api#tag-Group
+type UserGroup struct {
+	ID          UserGroupID `json:"id,omitempty"`
+	Name        string      `json:"name,omitempty"`
+	WorkspaceID WorkspaceID `json:"workspaceId,omitempty"`
+	UserIDs     []UserID    `json:"userIds,omitempty"`
+
+	// Extra holds JSON properties unknown to this package.
+	// See: WithPreserveUnknownFields.
+	Extra map[string]json.RawMessage `json:"-"`
+}
+
+type UserGroupSortColumn string
+
+const (
+	UserGroupSortColumnName UserGroupSortColumn = "NAME"
+)
+
+// WithUserGroupSortColumn set fields you want to sort against.
+func WithUserGroupSortColumn(sortColumn UserGroupSortColumn) RequestOption {
+	return RequestOption{
+		paramsProvider: func(v url.Values) string {
+			v.Set(sortColumnKey, string(sortColumn))
+			return sortColumnKey
+		},
+	}
+}
+
+type userGroupFields struct {
+	Name string `json:"name"`
+}
+
+type userGroupUserFields struct {
+	UserID UserID `json:"userId"`
+}
+
+// All get all UserGroup resource based on filter given, eg: WithName and
+// WithProjectID.
+func (u *UserGroupNode) All(workspaceID WorkspaceID, opts ...RequestOption) ([]UserGroup,
+	error) {
+	endpoint := fmt.Sprintf("%s/workspaces/%s/user-groups", u.endpoint, workspaceID)
+	res, err := u.requester.get(userGroupAllRequest(endpoint, opts))
+	if err != nil {
+		return nil, fmt.Errorf("get: %w", err)
+	}
+	result := make([]UserGroup, 0)
+	if err := u.requester.unmarshal(res, &result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func userGroupAllRequest(endpoint string, options []RequestOption) requestOptions {
+	res := requestOptions{
+		resource:  ResourceUserGroup,
+		operation: OperationList,
+		endpoint:  endpoint,
+	}
+	res.params = url.Values{}
+	res.params.Add(pageKey, strconv.Itoa(defaultPage))
+	res.params.Add(pageSizeKey, strconv.Itoa(defaultPageSize))
+	res.params.Add(sortOrderKey, string(defaultSortOrder))
+	for _, opt := range options {
+		if opt.paramsProvider != nil {
+			opt.paramsProvider(res.params)
+		}
+	}
+	if projectID := res.params.Get(projectKey); projectID != "" {
+		res.params.Set(projectIDKey, projectID)
+	}
+	res.params.Del(projectKey)
+	injectContext(&res, options)
+
+	return res
+}
+
+// Names get name of every UserGroup in workspace keyed by its id, fetching
+// all pages. It's useful for rendering UserGroupIds of hydrated Project tasks.
+func (u *UserGroupNode) Names(workspaceID WorkspaceID,
+	opts ...RequestOption) (map[UserGroupID]string, error) {
+	names := make(map[UserGroupID]string)
+	for page := defaultPage; ; page++ {
+		pageOpts := append(opts[:len(opts):len(opts)], WithPageSize(defaultPageSize),
+			WithPage(page))
+		groups, err := u.All(workspaceID, pageOpts...)
+		if err != nil {
+			return nil, err
+		}
+		added := false
+		for _, group := range groups {
+			if _, ok := names[group.ID]; !ok {
+				added = true
+			}
+			names[group.ID] = group.Name
+		}
+		// Page adding no new group means pagination is ignored or exhausted.
+		if len(groups) < defaultPageSize || !added {
+			return names, nil
+		}
+	}
+}
+
+// Add create new UserGroup with name given.
+func (u *UserGroupNode) Add(workspaceID WorkspaceID, name string,
+	opts ...RequestOption) (*UserGroup, error) {
+	endpoint := fmt.Sprintf("%s/workspaces/%s/user-groups", u.endpoint, workspaceID)
+	res, err := u.requester.post(userGroupAddRequest(endpoint, name, opts))
+	if err != nil {
+		return nil, fmt.Errorf("post: %w", err)
+	}
+	result := new(UserGroup)
+	if err := u.requester.unmarshal(res, &result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func userGroupAddRequest(endpoint string, name string,
+	options []RequestOption) requestOptions {
+	res := requestOptions{
+		resource: ResourceUserGroup,
+		endpoint: endpoint,
+		fields:   userGroupFields{Name: name},
+	}
+	injectContext(&res, options)
+
+	return res
+}
+
+// Update rename existing UserGroup.
+func (u *UserGroupNode) Update(workspaceID WorkspaceID, id UserGroupID, name string,
+	opts ...RequestOption) (*UserGroup, error) {
+	endpoint := fmt.Sprintf("%s/workspaces/%s/user-groups/%s", u.endpoint, workspaceID, id)
+	res, err := u.requester.put(userGroupUpdateRequest(endpoint, name, opts))
+	if err != nil {
+		return nil, fmt.Errorf("put: %w", err)
+	}
+	result := new(UserGroup)
+	if err := u.requester.unmarshal(res, &result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func userGroupUpdateRequest(endpoint string, name string,
+	options []RequestOption) requestOptions {
+	res := requestOptions{
+		resource: ResourceUserGroup,
+		endpoint: endpoint,
+		fields:   userGroupFields{Name: name},
+	}
+	injectContext(&res, options)
+
+	return res
+}
+
+// Delete existing UserGroup, and return the deleted UserGroup. Nil UserGroup is
+// returned when Clockify respond without body.
+func (u *UserGroupNode) Delete(workspaceID WorkspaceID, id UserGroupID,
+	opts ...RequestOption) (*UserGroup, error) {
+	endpoint := fmt.Sprintf("%s/workspaces/%s/user-groups/%s", u.endpoint, workspaceID, id)
+	res, err := u.requester.del(userGroupDeleteRequest(endpoint, opts))
+	if err != nil {
+		return nil, fmt.Errorf("del: %w", err)
+	}
+	if emptyBody(res) {
+		return nil, nil
+	}
+	result := new(UserGroup)
+	if err := u.requester.unmarshal(res, &result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func userGroupDeleteRequest(endpoint string, options []RequestOption) requestOptions {
+	res := requestOptions{
+		resource: ResourceUserGroup,
+		endpoint: endpoint,
+	}
+	injectContext(&res, options)
+
+	return res
+}
+
+// AddUser add user to existing UserGroup.
+func (u *UserGroupNode) AddUser(workspaceID WorkspaceID, id UserGroupID, userID UserID,
+	opts ...RequestOption) (*UserGroup, error) {
+	endpoint := fmt.Sprintf("%s/workspaces/%s/user-groups/%s/users", u.endpoint, workspaceID,
+		id)
+	res, err := u.requester.post(userGroupAddUserRequest(endpoint, userID, opts))
+	if err != nil {
+		return nil, fmt.Errorf("post: %w", err)
+	}
+	result := new(UserGroup)
+	if err := u.requester.unmarshal(res, &result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func userGroupAddUserRequest(endpoint string, userID UserID,
+	options []RequestOption) requestOptions {
+	res := requestOptions{
+		resource: ResourceUserGroup,
+		endpoint: endpoint,
+		fields:   userGroupUserFields{UserID: userID},
+	}
+	injectContext(&res, options)
+
+	return res
+}
+
+// RemoveUser remove user from existing UserGroup, and return the UserGroup. Nil
+// UserGroup is returned when Clockify respond without body.
+func (u *UserGroupNode) RemoveUser(workspaceID WorkspaceID, id UserGroupID, userID UserID,
+	opts ...RequestOption) (*UserGroup, error) {
+	endpoint := fmt.Sprintf("%s/workspaces/%s/user-groups/%s/users/%s", u.endpoint,
+		workspaceID, id, userID)
+	res, err := u.requester.del(userGroupRemoveUserRequest(endpoint, opts))
+	if err != nil {
+		return nil, fmt.Errorf("del: %w", err)
+	}
+	if emptyBody(res) {
+		return nil, nil
+	}
+	result := new(UserGroup)
+	if err := u.requester.unmarshal(res, &result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func userGroupRemoveUserRequest(endpoint string, options []RequestOption) requestOptions {
+	res := requestOptions{
+		resource: ResourceUserGroup,
+		endpoint: endpoint,
+	}
+	injectContext(&res, options)
+
+	return res
+}
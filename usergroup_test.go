@@ -0,0 +1,164 @@
+package glockify
+
+import (
+	"fmt"
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/suite"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+)
+
+type UserGroupTestSuite struct {
+	suite.Suite
+	server     *httptest.Server
+	wantBody   string
+	noContent  bool
+	ignorePage bool
+}
+
+func (s *UserGroupTestSuite) SetupTest() {
+	s.wantBody = ""
+	s.noContent = false
+	s.ignorePage = false
+
+	testMux := mux.NewRouter()
+	testMux.HandleFunc("/workspaces/{workspaceID}/user-groups", s.all()).Methods("GET")
+	testMux.HandleFunc("/workspaces/{workspaceID}/user-groups", s.write()).Methods("POST")
+	testMux.HandleFunc("/workspaces/{workspaceID}/user-groups/{id}",
+		s.write()).Methods("PUT", "DELETE")
+	testMux.HandleFunc("/workspaces/{workspaceID}/user-groups/{id}/users",
+		s.write()).Methods("POST")
+	testMux.HandleFunc("/workspaces/{workspaceID}/user-groups/{id}/users/{userID}",
+		s.write()).Methods("DELETE")
+
+	s.server = newJSONServer(testMux)
+}
+
+func (s *UserGroupTestSuite) TearDownTest() {
+	s.server.Close()
+}
+
+func (s *UserGroupTestSuite) all() func(http.ResponseWriter, *http.Request) {
+	return func(w http.ResponseWriter, r *http.Request) {
+		s.Require().True(checkAuthHeader(r))
+		query := r.URL.Query()
+		s.Require().Equal("Project1", query.Get("projectId"))
+		s.Require().Empty(query.Get("project"))
+
+		// first page is full, second page has one group
+		page, err := strconv.Atoi(query.Get("page"))
+		s.Require().Nil(err)
+		if s.ignorePage {
+			page = 1
+		}
+		pageSize, err := strconv.Atoi(query.Get("page-size"))
+		s.Require().Nil(err)
+		count := pageSize
+		if page > 1 {
+			count = 1
+		}
+		w.Header().Set("content-type", "application/json")
+		_, err = fmt.Fprint(w, "[")
+		s.Require().Nil(err)
+		for i := 0; i < count; i++ {
+			if i > 0 {
+				_, err = fmt.Fprint(w, ",")
+				s.Require().Nil(err)
+			}
+			_, err = fmt.Fprintf(w, `{"id":"Group%d-%d","name":"Group %d-%d"}`, page, i, page, i)
+			s.Require().Nil(err)
+		}
+		_, err = fmt.Fprint(w, "]")
+		s.Require().Nil(err)
+	}
+}
+
+func (s *UserGroupTestSuite) write() func(http.ResponseWriter, *http.Request) {
+	return func(w http.ResponseWriter, r *http.Request) {
+		s.Require().True(checkAuthHeader(r))
+		body, err := ioutil.ReadAll(r.Body)
+		s.Require().Nil(err)
+		if s.wantBody == "" {
+			s.Require().Empty(body)
+		} else {
+			s.Require().JSONEq(s.wantBody, string(body))
+		}
+
+		if r.Method == http.MethodPost {
+			w.WriteHeader(http.StatusCreated)
+		}
+		if s.noContent {
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+		_, err = fmt.Fprintf(w, `{"id":"Group1","name":"Group","userIds":["User1"]}`)
+		s.Require().Nil(err)
+	}
+}
+
+func (s *UserGroupTestSuite) glock() *Glockify {
+	return New(dummyAPIKey, WithEndpoint(Endpoint{Base: s.server.URL}))
+}
+
+func (s *UserGroupTestSuite) TestAll() {
+	groups, err := s.glock().UserGroup.All("Workspace1", WithProjectID("Project1"))
+	s.Require().Nil(err)
+	s.Require().Len(groups, defaultPageSize)
+}
+
+func (s *UserGroupTestSuite) TestNames() {
+	names, err := s.glock().UserGroup.Names("Workspace1", WithProjectID("Project1"))
+	s.Require().Nil(err)
+	s.Require().Len(names, defaultPageSize+1)
+	s.Require().Equal("Group 2-0", names["Group2-0"])
+}
+
+func (s *UserGroupTestSuite) TestNamesIgnoredPage() {
+	s.ignorePage = true
+	names, err := s.glock().UserGroup.Names("Workspace1", WithProjectID("Project1"))
+	s.Require().Nil(err)
+	s.Require().Len(names, defaultPageSize)
+}
+
+func (s *UserGroupTestSuite) TestWrite() {
+	glock := s.glock()
+
+	s.wantBody = `{"name":"Group"}`
+	group, err := glock.UserGroup.Add("Workspace1", "Group")
+	s.Require().Nil(err)
+	s.Require().Equal([]UserID{"User1"}, group.UserIDs)
+
+	_, err = glock.UserGroup.Update("Workspace1", "Group1", "Group")
+	s.Require().Nil(err)
+
+	s.wantBody = `{"userId":"User1"}`
+	_, err = glock.UserGroup.AddUser("Workspace1", "Group1", "User1")
+	s.Require().Nil(err)
+
+	s.wantBody = ""
+	group, err = glock.UserGroup.RemoveUser("Workspace1", "Group1", "User1")
+	s.Require().Nil(err)
+	s.Require().Equal(UserGroupID("Group1"), group.ID)
+	group, err = glock.UserGroup.Delete("Workspace1", "Group1")
+	s.Require().Nil(err)
+	s.Require().Equal(UserGroupID("Group1"), group.ID)
+}
+
+func (s *UserGroupTestSuite) TestDeleteNoContent() {
+	glock := s.glock()
+	s.noContent = true
+
+	group, err := glock.UserGroup.RemoveUser("Workspace1", "Group1", "User1")
+	s.Require().Nil(err)
+	s.Require().Nil(group)
+	group, err = glock.UserGroup.Delete("Workspace1", "Group1")
+	s.Require().Nil(err)
+	s.Require().Nil(group)
+}
+
+func TestUserGroupNode(t *testing.T) {
+	suite.Run(t, &UserGroupTestSuite{})
+}
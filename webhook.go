@@ -0,0 +1,484 @@
+package glockify
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/url"
+)
+
+// WebhookNode manipulating Webhook resource.
+type WebhookNode struct {
+	endpoint  string
+	requester *requester
+}
+
+// Webhook represent Clockify's webhook resource. AuthToken is sent by Clockify
+// in Clockify-Signature header of every event, see: WebhookHandler.
+// See: https://clockify.me/developers-api#tag-Webhooks
+type Webhook struct {
+	ID                WebhookID                `json:"id,omitempty"`
+	Name              string                   `json:"name,omitempty"`
+	URL               string                   `json:"url,omitempty"`
+	UserID            UserID                   `json:"userId,omitempty"`
+	WorkspaceID       WorkspaceID              `json:"workspaceId,omitempty"`
+	WebhookEvent      WebhookEvent             `json:"webhookEvent,omitempty"`
+	TriggerSourceType WebhookTriggerSourceType `json:"triggerSourceType,omitempty"`
+	TriggerSource     []string                 `json:"triggerSource,omitempty"`
+	Enabled           bool                     `json:"enabled,omitempty"`
+	AuthToken         string                   `json:"authToken,omitempty"`
+
+	// Extra holds JSON properties unknown to this package.
+	// See: WithPreserveUnknownFields.
+	Extra map[string]json.RawMessage `json:"-"`
+}
+
+// TriggerWorkspaceIDs return TriggerSource as WorkspaceID when TriggerSourceType is
+// WebhookTriggerSourceTypeWorkspace, otherwise nil.
+func (w Webhook) TriggerWorkspaceIDs() []WorkspaceID {
+	if w.TriggerSourceType != WebhookTriggerSourceTypeWorkspace {
+		return nil
+	}
+	res := make([]WorkspaceID, len(w.TriggerSource))
+	for i, id := range w.TriggerSource {
+		res[i] = WorkspaceID(id)
+	}
+	return res
+}
+
+// TriggerProjectIDs return TriggerSource as ProjectID when TriggerSourceType is
+// WebhookTriggerSourceTypeProject, otherwise nil.
+func (w Webhook) TriggerProjectIDs() []ProjectID {
+	if w.TriggerSourceType != WebhookTriggerSourceTypeProject {
+		return nil
+	}
+	res := make([]ProjectID, len(w.TriggerSource))
+	for i, id := range w.TriggerSource {
+		res[i] = ProjectID(id)
+	}
+	return res
+}
+
+// TriggerTaskIDs return TriggerSource as TaskID when TriggerSourceType is
+// WebhookTriggerSourceTypeTask, otherwise nil.
+func (w Webhook) TriggerTaskIDs() []TaskID {
+	if w.TriggerSourceType != WebhookTriggerSourceTypeTask {
+		return nil
+	}
+	res := make([]TaskID, len(w.TriggerSource))
+	for i, id := range w.TriggerSource {
+		res[i] = TaskID(id)
+	}
+	return res
+}
+
+// TriggerTagIDs return TriggerSource as TagID when TriggerSourceType is
+// WebhookTriggerSourceTypeTag, otherwise nil.
+func (w Webhook) TriggerTagIDs() []TagID {
+	if w.TriggerSourceType != WebhookTriggerSourceTypeTag {
+		return nil
+	}
+	res := make([]TagID, len(w.TriggerSource))
+	for i, id := range w.TriggerSource {
+		res[i] = TagID(id)
+	}
+	return res
+}
+
+// TriggerUserIDs return TriggerSource as UserID when TriggerSourceType is
+// WebhookTriggerSourceTypeUser, otherwise nil.
+func (w Webhook) TriggerUserIDs() []UserID {
+	if w.TriggerSourceType != WebhookTriggerSourceTypeUser {
+		return nil
+	}
+	res := make([]UserID, len(w.TriggerSource))
+	for i, id := range w.TriggerSource {
+		res[i] = UserID(id)
+	}
+	return res
+}
+
+// TriggerUserGroupIDs return TriggerSource as UserGroupID when TriggerSourceType is
+// WebhookTriggerSourceTypeUserGroup, otherwise nil.
+func (w Webhook) TriggerUserGroupIDs() []UserGroupID {
+	if w.TriggerSourceType != WebhookTriggerSourceTypeUserGroup {
+		return nil
+	}
+	res := make([]UserGroupID, len(w.TriggerSource))
+	for i, id := range w.TriggerSource {
+		res[i] = UserGroupID(id)
+	}
+	return res
+}
+
+// WebhookEvent is event which triggers webhook.
+type WebhookEvent string
+
+// Possible values of WebhookEvent
+const (
+	WebhookEventNewProject                   WebhookEvent = "NEW_PROJECT"
+	WebhookEventProjectUpdated               WebhookEvent = "PROJECT_UPDATED"
+	WebhookEventProjectDeleted               WebhookEvent = "PROJECT_DELETED"
+	WebhookEventNewTask                      WebhookEvent = "NEW_TASK"
+	WebhookEventTaskUpdated                  WebhookEvent = "TASK_UPDATED"
+	WebhookEventTaskDeleted                  WebhookEvent = "TASK_DELETED"
+	WebhookEventNewClient                    WebhookEvent = "NEW_CLIENT"
+	WebhookEventClientUpdated                WebhookEvent = "CLIENT_UPDATED"
+	WebhookEventClientDeleted                WebhookEvent = "CLIENT_DELETED"
+	WebhookEventNewTag                       WebhookEvent = "NEW_TAG"
+	WebhookEventTagUpdated                   WebhookEvent = "TAG_UPDATED"
+	WebhookEventTagDeleted                   WebhookEvent = "TAG_DELETED"
+	WebhookEventNewTimerStarted              WebhookEvent = "NEW_TIMER_STARTED"
+	WebhookEventTimerStopped                 WebhookEvent = "TIMER_STOPPED"
+	WebhookEventNewTimeEntry                 WebhookEvent = "NEW_TIME_ENTRY"
+	WebhookEventTimeEntryUpdated             WebhookEvent = "TIME_ENTRY_UPDATED"
+	WebhookEventTimeEntryDeleted             WebhookEvent = "TIME_ENTRY_DELETED"
+	WebhookEventUserJoinedWorkspace          WebhookEvent = "USER_JOINED_WORKSPACE"
+	WebhookEventUserDeletedFromWorkspace     WebhookEvent = "USER_DELETED_FROM_WORKSPACE"
+	WebhookEventUserGroupCreated             WebhookEvent = "USER_GROUP_CREATED"
+	WebhookEventUserGroupUpdated             WebhookEvent = "USER_GROUP_UPDATED"
+	WebhookEventUserGroupDeleted             WebhookEvent = "USER_GROUP_DELETED"
+	WebhookEventNewApprovalRequest           WebhookEvent = "NEW_APPROVAL_REQUEST"
+	WebhookEventApprovalRequestStatusUpdated WebhookEvent = "APPROVAL_REQUEST_STATUS_UPDATED"
+	WebhookEventUnknown                      WebhookEvent = enumUnknown
+)
+
+var webhookEvents = []string{
+	string(WebhookEventNewProject), string(WebhookEventProjectUpdated),
+	string(WebhookEventProjectDeleted), string(WebhookEventNewTask),
+	string(WebhookEventTaskUpdated), string(WebhookEventTaskDeleted),
+	string(WebhookEventNewClient), string(WebhookEventClientUpdated),
+	string(WebhookEventClientDeleted), string(WebhookEventNewTag),
+	string(WebhookEventTagUpdated), string(WebhookEventTagDeleted),
+	string(WebhookEventNewTimerStarted), string(WebhookEventTimerStopped),
+	string(WebhookEventNewTimeEntry), string(WebhookEventTimeEntryUpdated),
+	string(WebhookEventTimeEntryDeleted), string(WebhookEventUserJoinedWorkspace),
+	string(WebhookEventUserDeletedFromWorkspace), string(WebhookEventUserGroupCreated),
+	string(WebhookEventUserGroupUpdated), string(WebhookEventUserGroupDeleted),
+	string(WebhookEventNewApprovalRequest), string(WebhookEventApprovalRequestStatusUpdated),
+}
+
+// UnmarshalJSON decode WebhookEvent, unknown value decode into
+// WebhookEventUnknown.
+func (w *WebhookEvent) UnmarshalJSON(data []byte) error {
+	v, err := unmarshalEnum(data, string(WebhookEventUnknown), webhookEvents...)
+	*w = WebhookEvent(v)
+	return err
+}
+
+// WebhookTriggerSourceType is kind of resource which limits webhook triggers.
+type WebhookTriggerSourceType string
+
+// Possible values of WebhookTriggerSourceType
+const (
+	WebhookTriggerSourceTypeWorkspace WebhookTriggerSourceType = "WORKSPACE_ID"
+	WebhookTriggerSourceTypeProject   WebhookTriggerSourceType = "PROJECT_ID"
+	WebhookTriggerSourceTypeTask      WebhookTriggerSourceType = "TASK_ID"
+	WebhookTriggerSourceTypeTag       WebhookTriggerSourceType = "TAG_ID"
+	WebhookTriggerSourceTypeUser      WebhookTriggerSourceType = "USER_ID"
+	WebhookTriggerSourceTypeUserGroup WebhookTriggerSourceType = "USER_GROUP_ID"
+	WebhookTriggerSourceTypeUnknown   WebhookTriggerSourceType = enumUnknown
+)
+
+// UnmarshalJSON decode WebhookTriggerSourceType, unknown value decode into
+// WebhookTriggerSourceTypeUnknown.
+func (w *WebhookTriggerSourceType) UnmarshalJSON(data []byte) error {
+	v, err := unmarshalEnum(data, string(WebhookTriggerSourceTypeUnknown),
+		string(WebhookTriggerSourceTypeWorkspace), string(WebhookTriggerSourceTypeProject),
+		string(WebhookTriggerSourceTypeTask), string(WebhookTriggerSourceTypeTag),
+		string(WebhookTriggerSourceTypeUser), string(WebhookTriggerSourceTypeUserGroup))
+	*w = WebhookTriggerSourceType(v)
+	return err
+}
+
+const (
+	urlKey           = "url"
+	webhookEventKey  = "webhookEvent"
+	triggerSourceKey = "triggerSource"
+)
+
+// WithURL set webhook's target url.
+func WithURL(u string) RequestOption {
+	return RequestOption{
+		paramsProvider: func(v url.Values) string {
+			v.Set(urlKey, u)
+			return urlKey
+		},
+	}
+}
+
+// WithWebhookEvent set event which triggers webhook.
+func WithWebhookEvent(event WebhookEvent) RequestOption {
+	return RequestOption{
+		paramsProvider: func(v url.Values) string {
+			v.Set(webhookEventKey, string(event))
+			return webhookEventKey
+		},
+	}
+}
+
+type webhookTriggerSource struct {
+	Type WebhookTriggerSourceType `json:"type"`
+	IDs  []string                 `json:"ids"`
+}
+
+// withTriggerSource limit webhook to be triggered only by resources of
+// sourceType with ids given. Default to every resource of workspace.
+func withTriggerSource(sourceType WebhookTriggerSourceType, ids []string) RequestOption {
+	return RequestOption{
+		paramsProvider: func(v url.Values) string {
+			if ids == nil {
+				ids = []string{}
+			}
+			ts, err := json.Marshal(webhookTriggerSource{Type: sourceType, IDs: ids})
+			if err != nil {
+				log.Fatalf("%v", err)
+			}
+			v.Set(triggerSourceKey, string(ts))
+			return triggerSourceKey
+		},
+	}
+}
+
+// WithTriggerWorkspaces limit webhook to be triggered only by workspaces given.
+func WithTriggerWorkspaces(ids ...WorkspaceID) RequestOption {
+	source := make([]string, len(ids))
+	for i, id := range ids {
+		source[i] = string(id)
+	}
+	return withTriggerSource(WebhookTriggerSourceTypeWorkspace, source)
+}
+
+// WithTriggerProjects limit webhook to be triggered only by projects given.
+func WithTriggerProjects(ids ...ProjectID) RequestOption {
+	source := make([]string, len(ids))
+	for i, id := range ids {
+		source[i] = string(id)
+	}
+	return withTriggerSource(WebhookTriggerSourceTypeProject, source)
+}
+
+// WithTriggerTasks limit webhook to be triggered only by tasks given.
+func WithTriggerTasks(ids ...TaskID) RequestOption {
+	source := make([]string, len(ids))
+	for i, id := range ids {
+		source[i] = string(id)
+	}
+	return withTriggerSource(WebhookTriggerSourceTypeTask, source)
+}
+
+// WithTriggerTags limit webhook to be triggered only by tags given.
+func WithTriggerTags(ids ...TagID) RequestOption {
+	source := make([]string, len(ids))
+	for i, id := range ids {
+		source[i] = string(id)
+	}
+	return withTriggerSource(WebhookTriggerSourceTypeTag, source)
+}
+
+// WithTriggerUsers limit webhook to be triggered only by users given.
+func WithTriggerUsers(ids ...UserID) RequestOption {
+	source := make([]string, len(ids))
+	for i, id := range ids {
+		source[i] = string(id)
+	}
+	return withTriggerSource(WebhookTriggerSourceTypeUser, source)
+}
+
+// WithTriggerUserGroups limit webhook to be triggered only by user groups given.
+func WithTriggerUserGroups(ids ...UserGroupID) RequestOption {
+	source := make([]string, len(ids))
+	for i, id := range ids {
+		source[i] = string(id)
+	}
+	return withTriggerSource(WebhookTriggerSourceTypeUserGroup, source)
+}
+
+type webhookFields struct {
+	Name              *string                  `json:"name,omitempty"`
+	URL               *string                  `json:"url,omitempty"`
+	WebhookEvent      WebhookEvent             `json:"webhookEvent,omitempty"`
+	TriggerSourceType WebhookTriggerSourceType `json:"triggerSourceType,omitempty"`
+	TriggerSource     []string                 `json:"triggerSource,omitempty"`
+}
+
+type webhooksResponse struct {
+	WorkspaceWebhookCount int       `json:"workspaceWebhookCount"`
+	Webhooks              []Webhook `json:"webhooks"`
+}
+
+// All get all Webhook of workspace.
+func (w *WebhookNode) All(workspaceID WorkspaceID, opts ...RequestOption) ([]Webhook, error) {
+	endpoint := fmt.Sprintf("%s/workspaces/%s/webhooks", w.endpoint, workspaceID)
+	res, err := w.requester.get(webhookReadRequest(endpoint, OperationList, opts))
+	if err != nil {
+		return nil, fmt.Errorf("get: %w", err)
+	}
+	result := new(webhooksResponse)
+	if err := w.requester.unmarshal(res, &result); err != nil {
+		return nil, err
+	}
+	if result.Webhooks == nil {
+		return make([]Webhook, 0), nil
+	}
+	return result.Webhooks, nil
+}
+
+// Get one Webhook by its id.
+func (w *WebhookNode) Get(workspaceID WorkspaceID, id WebhookID,
+	opts ...RequestOption) (*Webhook, error) {
+	endpoint := fmt.Sprintf("%s/workspaces/%s/webhooks/%s", w.endpoint, workspaceID, id)
+	res, err := w.requester.get(webhookReadRequest(endpoint, OperationRead, opts))
+	if err != nil {
+		return nil, fmt.Errorf("get: %w", err)
+	}
+	result := new(Webhook)
+	if err := w.requester.unmarshal(res, &result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func webhookReadRequest(endpoint string, operation Operation,
+	options []RequestOption) requestOptions {
+	res := requestOptions{
+		resource:  ResourceWebhook,
+		operation: operation,
+		endpoint:  endpoint,
+	}
+	injectContext(&res, options)
+
+	return res
+}
+
+// Add create new Webhook which send event given to targetURL. Use
+// WithTriggerProjects or other WithTriggerXxx to limit resources triggering it.
+func (w *WebhookNode) Add(workspaceID WorkspaceID, name string, targetURL string,
+	event WebhookEvent, opts ...RequestOption) (*Webhook, error) {
+	endpoint := fmt.Sprintf("%s/workspaces/%s/webhooks", w.endpoint, workspaceID)
+	req := webhookWriteRequest(endpoint, opts)
+	fields := req.fields.(webhookFields)
+	fields.Name = &name
+	fields.URL = &targetURL
+	fields.WebhookEvent = event
+	if fields.TriggerSourceType == "" {
+		fields.TriggerSourceType = WebhookTriggerSourceTypeWorkspace
+		fields.TriggerSource = []string{string(workspaceID)}
+	}
+	req.fields = fields
+
+	res, err := w.requester.post(req)
+	if err != nil {
+		return nil, fmt.Errorf("post: %w", err)
+	}
+	result := new(Webhook)
+	if err := w.requester.unmarshal(res, &result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// Update existing Webhook based on options given, eg: WithName, WithURL,
+// WithWebhookEvent and WithTriggerXxx options. Only fields set by options are sent.
+func (w *WebhookNode) Update(workspaceID WorkspaceID, id WebhookID,
+	opts ...RequestOption) (*Webhook, error) {
+	endpoint := fmt.Sprintf("%s/workspaces/%s/webhooks/%s", w.endpoint, workspaceID, id)
+	res, err := w.requester.put(webhookWriteRequest(endpoint, opts))
+	if err != nil {
+		return nil, fmt.Errorf("put: %w", err)
+	}
+	result := new(Webhook)
+	if err := w.requester.unmarshal(res, &result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func webhookWriteRequest(endpoint string, options []RequestOption) requestOptions {
+	res := requestOptions{
+		resource: ResourceWebhook,
+		endpoint: endpoint,
+	}
+
+	fields := webhookFields{}
+	for _, opt := range options {
+		if opt.paramsProvider != nil {
+			params := url.Values{}
+			key := opt.paramsProvider(params)
+			switch key {
+			case nameKey:
+				val := params.Get(key)
+				fields.Name = &val
+			case urlKey:
+				val := params.Get(key)
+				fields.URL = &val
+			case webhookEventKey:
+				fields.WebhookEvent = WebhookEvent(params.Get(key))
+			case triggerSourceKey:
+				ts := webhookTriggerSource{}
+				_ = json.Unmarshal([]byte(params.Get(key)), &ts)
+				fields.TriggerSourceType = ts.Type
+				fields.TriggerSource = ts.IDs
+			}
+		}
+	}
+	res.fields = fields
+	injectContext(&res, options)
+
+	return res
+}
+
+// Delete existing Webhook, and return the deleted Webhook. Nil Webhook is
+// returned when Clockify respond without body.
+func (w *WebhookNode) Delete(workspaceID WorkspaceID, id WebhookID,
+	opts ...RequestOption) (*Webhook, error) {
+	endpoint := fmt.Sprintf("%s/workspaces/%s/webhooks/%s", w.endpoint, workspaceID, id)
+	res, err := w.requester.del(webhookDeleteRequest(endpoint, opts))
+	if err != nil {
+		return nil, fmt.Errorf("del: %w", err)
+	}
+	if emptyBody(res) {
+		return nil, nil
+	}
+	result := new(Webhook)
+	if err := w.requester.unmarshal(res, &result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func webhookDeleteRequest(endpoint string, options []RequestOption) requestOptions {
+	res := requestOptions{
+		resource: ResourceWebhook,
+		endpoint: endpoint,
+	}
+	injectContext(&res, options)
+
+	return res
+}
+
+// RegenerateToken replace AuthToken of existing Webhook, and return the
+// Webhook with its new token.
+func (w *WebhookNode) RegenerateToken(workspaceID WorkspaceID, id WebhookID,
+	opts ...RequestOption) (*Webhook, error) {
+	endpoint := fmt.Sprintf("%s/workspaces/%s/webhooks/%s/token", w.endpoint, workspaceID, id)
+	res, err := w.requester.patch(webhookRegenerateTokenRequest(endpoint, opts))
+	if err != nil {
+		return nil, fmt.Errorf("patch: %w", err)
+	}
+	result := new(Webhook)
+	if err := w.requester.unmarshal(res, &result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func webhookRegenerateTokenRequest(endpoint string, options []RequestOption) requestOptions {
+	res := requestOptions{
+		resource: ResourceWebhook,
+		endpoint: endpoint,
+	}
+	injectContext(&res, options)
+
+	return res
+}
@@ -0,0 +1,188 @@
+package glockify
+
+import (
+	"fmt"
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/suite"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+type WebhookTestSuite struct {
+	suite.Suite
+	server   webhookMockServer
+	wantBody string
+}
+
+type webhookMockServer struct {
+	baseServer *httptest.Server
+}
+
+func (s *WebhookTestSuite) SetupTest() {
+	s.wantBody = ""
+
+	testMux := mux.NewRouter()
+
+	testMux.HandleFunc("/workspaces/{workspaceID}/webhooks", s.all()).Methods("GET")
+	testMux.HandleFunc("/workspaces/{workspaceID}/webhooks/{webhookID}", s.write()).
+		Methods("GET")
+	testMux.HandleFunc("/workspaces/{workspaceID}/webhooks", s.write()).Methods("POST")
+	testMux.HandleFunc("/workspaces/{workspaceID}/webhooks/{webhookID}", s.write()).
+		Methods("PUT")
+	testMux.HandleFunc("/workspaces/{workspaceID}/webhooks/{webhookID}", s.write()).
+		Methods("DELETE")
+	testMux.HandleFunc("/workspaces/{workspaceID}/webhooks/{webhookID}/token", s.write()).
+		Methods("PATCH")
+
+	s.server = webhookMockServer{
+		baseServer: newJSONServer(testMux),
+	}
+}
+
+func (s *WebhookTestSuite) TearDownTest() {
+	s.server.baseServer.Close()
+}
+
+func (s *WebhookTestSuite) all() func(http.ResponseWriter, *http.Request) {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if !checkAuthHeader(r) {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		s.Require().Equal("Workspace1", mux.Vars(r)["workspaceID"])
+
+		_, err := fmt.Fprintf(w, `{"workspaceWebhookCount":1,"webhooks":[{"id":"Webhook1",
+"webhookEvent":"NEW_PROJECT","triggerSourceType":"WORKSPACE_ID",
+"triggerSource":["Workspace1"]}]}`)
+		s.Require().Nil(err)
+	}
+}
+
+func (s *WebhookTestSuite) write() func(http.ResponseWriter, *http.Request) {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if !checkAuthHeader(r) {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		body, err := ioutil.ReadAll(r.Body)
+		s.Require().Nil(err)
+		if s.wantBody == "" {
+			s.Require().Empty(body)
+		} else {
+			s.Require().JSONEq(s.wantBody, string(body))
+		}
+
+		if r.Method == http.MethodPost {
+			w.WriteHeader(http.StatusCreated)
+		}
+		if r.Method == http.MethodDelete && mux.Vars(r)["webhookID"] != "Webhook1" {
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+		_, err = fmt.Fprintf(w, `{"id":"Webhook1","name":"Hook","workspaceId":"Workspace1",
+"webhookEvent":"SOMETHING_NEW","authToken":"Token1"}`)
+		s.Require().Nil(err)
+	}
+}
+
+func (s *WebhookTestSuite) glock() *Glockify {
+	return New(dummyAPIKey, WithEndpoint(Endpoint{
+		Base: s.server.baseServer.URL,
+	}))
+}
+
+func (s *WebhookTestSuite) TestAll() {
+	webhooks, err := s.glock().Webhook.All("Workspace1")
+	s.Require().Nil(err)
+	s.Require().Len(webhooks, 1)
+	s.Require().Equal(WebhookEventNewProject, webhooks[0].WebhookEvent)
+	s.Require().Equal(WebhookTriggerSourceTypeWorkspace, webhooks[0].TriggerSourceType)
+	s.Require().Equal([]string{"Workspace1"}, webhooks[0].TriggerSource)
+	s.Require().Equal([]WorkspaceID{"Workspace1"}, webhooks[0].TriggerWorkspaceIDs())
+	s.Require().Nil(webhooks[0].TriggerProjectIDs())
+}
+
+func (s *WebhookTestSuite) TestGet() {
+	webhook, err := s.glock().Webhook.Get("Workspace1", "Webhook1")
+	s.Require().Nil(err)
+	s.Require().Equal(WebhookID("Webhook1"), webhook.ID)
+	s.Require().Equal(WebhookEventUnknown, webhook.WebhookEvent)
+}
+
+var testsWebhookAdd = []struct {
+	name     string
+	options  []RequestOption
+	wantBody string
+}{
+	{
+		name: "Default Trigger Source",
+		wantBody: `{"name":"Hook","url":"https://example.com/hook","webhookEvent":"TIMER_STOPPED",
+"triggerSourceType":"WORKSPACE_ID","triggerSource":["Workspace1"]}`,
+	},
+	{
+		name:    "Set Trigger Source",
+		options: []RequestOption{WithTriggerProjects("Project1")},
+		wantBody: `{"name":"Hook","url":"https://example.com/hook","webhookEvent":"TIMER_STOPPED",
+"triggerSourceType":"PROJECT_ID","triggerSource":["Project1"]}`,
+	},
+	{
+		name:    "Set User Group Trigger Source",
+		options: []RequestOption{WithTriggerUserGroups(UserGroupIDs("Group1", "Group2")...)},
+		wantBody: `{"name":"Hook","url":"https://example.com/hook","webhookEvent":"TIMER_STOPPED",
+"triggerSourceType":"USER_GROUP_ID","triggerSource":["Group1","Group2"]}`,
+	},
+}
+
+func (s *WebhookTestSuite) TestAdd() {
+	glock := s.glock()
+	for _, tc := range testsWebhookAdd {
+		s.Run(tc.name, func() {
+			s.wantBody = tc.wantBody
+			webhook, err := glock.Webhook.Add("Workspace1", "Hook", "https://example.com/hook",
+				WebhookEventTimerStopped, tc.options...)
+			s.Require().Nil(err)
+			s.Require().Equal("Hook", webhook.Name)
+		})
+	}
+}
+
+func (s *WebhookTestSuite) TestUpdate() {
+	s.wantBody = `{"url":"https://example.com/other","webhookEvent":"NEW_TIME_ENTRY"}`
+	_, err := s.glock().Webhook.Update("Workspace1", "Webhook1",
+		WithURL("https://example.com/other"), WithWebhookEvent(WebhookEventNewTimeEntry))
+	s.Require().Nil(err)
+
+	s.wantBody = `{"name":"Renamed"}`
+	_, err = s.glock().Webhook.Update("Workspace1", "Webhook1", WithName("Renamed"))
+	s.Require().Nil(err)
+}
+
+func (s *WebhookTestSuite) TestDelete() {
+	webhook, err := s.glock().Webhook.Delete("Workspace1", "Webhook1")
+	s.Require().Nil(err)
+	s.Require().Equal(WebhookID("Webhook1"), webhook.ID)
+
+	webhook, err = s.glock().Webhook.Delete("Workspace1", "Webhook2")
+	s.Require().Nil(err)
+	s.Require().Nil(webhook)
+
+	req := webhookDeleteRequest("", nil)
+	s.Require().Equal(ResourceWebhook, req.resource)
+	s.Require().Equal(OperationWrite, operationOf(http.MethodDelete, req))
+}
+
+func (s *WebhookTestSuite) TestRegenerateToken() {
+	webhook, err := s.glock().Webhook.RegenerateToken("Workspace1", "Webhook1")
+	s.Require().Nil(err)
+	s.Require().Equal("Token1", webhook.AuthToken)
+
+	req := webhookRegenerateTokenRequest("", nil)
+	s.Require().Equal(ResourceWebhook, req.resource)
+	s.Require().Equal(OperationWrite, operationOf(http.MethodPatch, req))
+}
+
+func TestWebhookNode(t *testing.T) {
+	suite.Run(t, &WebhookTestSuite{})
+}
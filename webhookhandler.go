@@ -0,0 +1,173 @@
+package glockify
+
+import (
+	"context"
+	"crypto/subtle"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"sync"
+)
+
+// Headers Clockify set on every webhook request.
+const (
+	WebhookSignatureHeader = "Clockify-Signature"
+	WebhookEventHeader     = "Clockify-Webhook-Event-Type"
+)
+
+const defaultMaxWebhookSize = 1 << 20
+
+// WebhookPayload is decoded webhook request. Only model matching Event is set,
+// eg: Project for WebhookEventNewProject, TimeEntry for WebhookEventTimerStopped.
+// Raw always holds the undecoded body, and is the only one set for event
+// unknown to this package.
+type WebhookPayload struct {
+	Event     WebhookEvent
+	Project   *Project
+	Client    *Client
+	Task      *Task
+	Tag       *Tag
+	TimeEntry *TimeEntry
+	User      *User
+	UserGroup *UserGroup
+	Raw       json.RawMessage
+}
+
+// WebhookCallback handle one webhook event. Returned error make WebhookHandler
+// respond with 500, so Clockify retries the request.
+type WebhookCallback func(ctx context.Context, payload *WebhookPayload) error
+
+// WebhookHandler is http.Handler receiving Clockify webhooks. It verifies
+// Clockify-Signature against its secrets, which are AuthToken of the webhooks,
+// decodes the body into WebhookPayload, and dispatches it to callback registered
+// for the event with On. Events without callback, including events unknown to
+// this package, are acknowledged without reading the body.
+//
+// It responds with:
+//   - 405 for method other than POST,
+//   - 401 for missing or unknown signature,
+//   - 400 for malformed or unreadable body of event with callback,
+//   - 413 for body larger than 1 MiB,
+//   - 500 when callback returns error,
+//   - 200 otherwise.
+type WebhookHandler struct {
+	mu        sync.RWMutex
+	secrets   [][]byte
+	callbacks map[WebhookEvent]WebhookCallback
+}
+
+// NewWebhookHandler instantiate WebhookHandler accepting any of secrets given.
+// Give both old and new token when rotating them with WebhookNode.RegenerateToken.
+func NewWebhookHandler(secrets ...string) *WebhookHandler {
+	h := &WebhookHandler{
+		callbacks: make(map[WebhookEvent]WebhookCallback),
+	}
+	for _, secret := range secrets {
+		h.secrets = append(h.secrets, []byte(secret))
+	}
+	return h
+}
+
+// On register callback for event given, replacing the previous one. Event
+// unknown to this package can be registered too, its payload only has Raw.
+func (h *WebhookHandler) On(event WebhookEvent, callback WebhookCallback) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	h.callbacks[event] = callback
+}
+
+func (h *WebhookHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		w.Header().Set("Allow", http.MethodPost)
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+	if !h.verify(r.Header.Get(WebhookSignatureHeader)) {
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+	event := WebhookEvent(r.Header.Get(WebhookEventHeader))
+	h.mu.RLock()
+	callback := h.callbacks[event]
+	h.mu.RUnlock()
+	if callback == nil {
+		w.WriteHeader(http.StatusOK)
+		return
+	}
+
+	body, err := ioutil.ReadAll(io.LimitReader(r.Body, defaultMaxWebhookSize+1))
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+	if len(body) > defaultMaxWebhookSize {
+		w.WriteHeader(http.StatusRequestEntityTooLarge)
+		return
+	}
+	payload, err := decodeWebhookPayload(event, body)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+	if err := callback(r.Context(), payload); err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+}
+
+// verify compare signature against every secret in constant time.
+func (h *WebhookHandler) verify(signature string) bool {
+	if signature == "" {
+		return false
+	}
+	valid := 0
+	for _, secret := range h.secrets {
+		valid |= subtle.ConstantTimeCompare([]byte(signature), secret)
+	}
+	return valid == 1
+}
+
+func decodeWebhookPayload(event WebhookEvent, body []byte) (*WebhookPayload, error) {
+	payload := &WebhookPayload{Event: event, Raw: body}
+
+	var v interface{}
+	switch event {
+	case WebhookEventNewProject, WebhookEventProjectUpdated, WebhookEventProjectDeleted:
+		payload.Project = new(Project)
+		v = payload.Project
+	case WebhookEventNewClient, WebhookEventClientUpdated, WebhookEventClientDeleted:
+		payload.Client = new(Client)
+		v = payload.Client
+	case WebhookEventNewTask, WebhookEventTaskUpdated, WebhookEventTaskDeleted:
+		payload.Task = new(Task)
+		v = payload.Task
+	case WebhookEventNewTag, WebhookEventTagUpdated, WebhookEventTagDeleted:
+		payload.Tag = new(Tag)
+		v = payload.Tag
+	case WebhookEventNewTimerStarted, WebhookEventTimerStopped, WebhookEventNewTimeEntry,
+		WebhookEventTimeEntryUpdated, WebhookEventTimeEntryDeleted:
+		payload.TimeEntry = new(TimeEntry)
+		v = payload.TimeEntry
+	case WebhookEventUserJoinedWorkspace, WebhookEventUserDeletedFromWorkspace:
+		payload.User = new(User)
+		v = payload.User
+	case WebhookEventUserGroupCreated, WebhookEventUserGroupUpdated,
+		WebhookEventUserGroupDeleted:
+		payload.UserGroup = new(UserGroup)
+		v = payload.UserGroup
+	default:
+		// Approval request and event unknown to this package only have Raw.
+		if !json.Valid(body) {
+			return nil, fmt.Errorf("event %s: invalid JSON", event)
+		}
+		return payload, nil
+	}
+	if err := json.Unmarshal(body, v); err != nil {
+		return nil, fmt.Errorf("event %s: %w", event, err)
+	}
+	return payload, nil
+}
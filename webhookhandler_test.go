@@ -0,0 +1,216 @@
+package glockify
+
+import (
+	"context"
+	"errors"
+	"github.com/stretchr/testify/suite"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+type WebhookHandlerTestSuite struct {
+	suite.Suite
+	handler  *WebhookHandler
+	received *WebhookPayload
+}
+
+func (s *WebhookHandlerTestSuite) SetupTest() {
+	s.received = nil
+	s.handler = NewWebhookHandler("OldToken", "Token1")
+	s.handler.On(WebhookEventNewProject, s.callback(nil))
+	s.handler.On(WebhookEventTimerStopped, s.callback(nil))
+	s.handler.On(WebhookEventNewClient, s.callback(errors.New("callback error")))
+	s.handler.On("SOMETHING_REGISTERED", s.callback(nil))
+}
+
+// errReader fail every read with error other than body too large.
+type errReader struct{}
+
+func (errReader) Read([]byte) (int, error) {
+	return 0, errors.New("connection reset")
+}
+
+func (s *WebhookHandlerTestSuite) callback(err error) WebhookCallback {
+	return func(ctx context.Context, payload *WebhookPayload) error {
+		s.received = payload
+		return err
+	}
+}
+
+var testsWebhookHandler = []struct {
+	name       string
+	method     string
+	signature  string
+	event      WebhookEvent
+	body       string
+	wantStatus int
+	wantCalled bool
+}{
+	{
+		name:       "Project Event",
+		method:     http.MethodPost,
+		signature:  "Token1",
+		event:      WebhookEventNewProject,
+		body:       `{"id":"Project1","name":"Project","clientId":"Client1"}`,
+		wantStatus: http.StatusOK,
+		wantCalled: true,
+	},
+	{
+		name:       "Old Token",
+		method:     http.MethodPost,
+		signature:  "OldToken",
+		event:      WebhookEventTimerStopped,
+		body:       `{"id":"TimeEntry1","timeInterval":{"start":"2021-01-01T00:00:00Z"}}`,
+		wantStatus: http.StatusOK,
+		wantCalled: true,
+	},
+	{
+		name:       "Without Callback",
+		method:     http.MethodPost,
+		signature:  "Token1",
+		event:      WebhookEventNewTag,
+		body:       `{"id":"Tag1"}`,
+		wantStatus: http.StatusOK,
+	},
+	{
+		name:       "Callback Error",
+		method:     http.MethodPost,
+		signature:  "Token1",
+		event:      WebhookEventNewClient,
+		body:       `{"id":"Client1"}`,
+		wantStatus: http.StatusInternalServerError,
+		wantCalled: true,
+	},
+	{
+		name:       "Wrong Method",
+		method:     http.MethodGet,
+		signature:  "Token1",
+		event:      WebhookEventNewProject,
+		wantStatus: http.StatusMethodNotAllowed,
+	},
+	{
+		name:       "Missing Signature",
+		method:     http.MethodPost,
+		event:      WebhookEventNewProject,
+		body:       `{"id":"Project1"}`,
+		wantStatus: http.StatusUnauthorized,
+	},
+	{
+		name:       "Wrong Signature",
+		method:     http.MethodPost,
+		signature:  "Token2",
+		event:      WebhookEventNewProject,
+		body:       `{"id":"Project1"}`,
+		wantStatus: http.StatusUnauthorized,
+	},
+	{
+		name:       "Unknown Event",
+		method:     http.MethodPost,
+		signature:  "Token1",
+		event:      "SOMETHING_NEW",
+		body:       `{"id":"Project1"}`,
+		wantStatus: http.StatusOK,
+	},
+	{
+		name:       "Unknown Event With Callback",
+		method:     http.MethodPost,
+		signature:  "Token1",
+		event:      "SOMETHING_REGISTERED",
+		body:       `{"id":"Something1"}`,
+		wantStatus: http.StatusOK,
+		wantCalled: true,
+	},
+	{
+		name:       "Unknown Event Malformed Body",
+		method:     http.MethodPost,
+		signature:  "Token1",
+		event:      "SOMETHING_REGISTERED",
+		body:       `{"id":`,
+		wantStatus: http.StatusBadRequest,
+	},
+	{
+		name:       "Without Callback Malformed Body",
+		method:     http.MethodPost,
+		signature:  "Token1",
+		event:      WebhookEventNewTag,
+		body:       `{"id":`,
+		wantStatus: http.StatusOK,
+	},
+	{
+		name:       "Malformed Body",
+		method:     http.MethodPost,
+		signature:  "Token1",
+		event:      WebhookEventNewProject,
+		body:       `{"id":`,
+		wantStatus: http.StatusBadRequest,
+	},
+	{
+		name:       "Body At Limit",
+		method:     http.MethodPost,
+		signature:  "Token1",
+		event:      WebhookEventNewProject,
+		body:       `{"name":"` + strings.Repeat("a", defaultMaxWebhookSize-11) + `"}`,
+		wantStatus: http.StatusOK,
+		wantCalled: true,
+	},
+	{
+		name:       "Body Too Large",
+		method:     http.MethodPost,
+		signature:  "Token1",
+		event:      WebhookEventNewProject,
+		body:       `{"name":"` + strings.Repeat("a", defaultMaxWebhookSize) + `"}`,
+		wantStatus: http.StatusRequestEntityTooLarge,
+	},
+}
+
+func (s *WebhookHandlerTestSuite) TestServeHTTP() {
+	for _, tc := range testsWebhookHandler {
+		s.Run(tc.name, func() {
+			s.received = nil
+			req := httptest.NewRequest(tc.method, "/webhook", strings.NewReader(tc.body))
+			if tc.signature != "" {
+				req.Header.Set(WebhookSignatureHeader, tc.signature)
+			}
+			req.Header.Set(WebhookEventHeader, string(tc.event))
+			rec := httptest.NewRecorder()
+
+			s.handler.ServeHTTP(rec, req)
+			s.Require().Equal(tc.wantStatus, rec.Code)
+			s.Require().Equal(tc.wantCalled, s.received != nil)
+			if tc.wantCalled {
+				s.Require().Equal(tc.event, s.received.Event)
+				s.Require().JSONEq(tc.body, string(s.received.Raw))
+			}
+		})
+	}
+}
+
+func (s *WebhookHandlerTestSuite) TestReadError() {
+	req := httptest.NewRequest(http.MethodPost, "/webhook", errReader{})
+	req.Header.Set(WebhookSignatureHeader, "Token1")
+	req.Header.Set(WebhookEventHeader, string(WebhookEventNewProject))
+	rec := httptest.NewRecorder()
+
+	s.handler.ServeHTTP(rec, req)
+	s.Require().Equal(http.StatusBadRequest, rec.Code)
+	s.Require().Nil(s.received)
+}
+
+func (s *WebhookHandlerTestSuite) TestTypedPayload() {
+	req := httptest.NewRequest(http.MethodPost, "/webhook",
+		strings.NewReader(`{"id":"Project1","name":"Project","clientId":"Client1"}`))
+	req.Header.Set(WebhookSignatureHeader, "Token1")
+	req.Header.Set(WebhookEventHeader, string(WebhookEventNewProject))
+
+	s.handler.ServeHTTP(httptest.NewRecorder(), req)
+	s.Require().NotNil(s.received.Project)
+	s.Require().Equal(ProjectID("Project1"), s.received.Project.ID)
+	s.Require().Equal(ClientID("Client1"), s.received.Project.ClientID)
+	s.Require().Nil(s.received.TimeEntry)
+}
+
+func TestWebhookHandler(t *testing.T) {
+	suite.Run(t, &WebhookHandlerTestSuite{})
+}
@@ -8,50 +8,49 @@ import (
 
 // WorkspaceNode manipulating Workspace resource.
 type WorkspaceNode struct {
-	endpoint string
-	apiKey   string
+	endpoint  string
+	requester *requester
 }
 
 // Workspace represent Clockify's workspace resource.
 // See: https://clockify.me/developers-api#tag-Workspace
 type Workspace struct {
-	ID                string            `json:"id,omitempty"`
+	ID                WorkspaceID       `json:"id,omitempty"`
 	Name              string            `json:"name,omitempty"`
-	HourlyRate        HourlyRate        `json:"hourlyRate,omitempty"`
+	HourlyRate        Money             `json:"hourlyRate,omitempty"`
 	ImageURL          string            `json:"imageUrl,omitempty"`
 	Memberships       []Memberships     `json:"memberships,omitempty"`
 	WorkspaceSettings WorkspaceSettings `json:"workspaceSettings,omitempty"`
-}
 
-// HourlyRate see: https://clockify.me/developers-api#tag-Workspace
-type HourlyRate struct {
-	Amount   int    `json:"amount,omitempty"`
-	Currency string `json:"currency,omitempty"`
+	// Extra holds JSON properties unknown to this package.
+	// See: WithPreserveUnknownFields.
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 // Memberships see: https://clockify.me/developers-api#tag-Workspace
 type Memberships struct {
-	HourlyRate       HourlyRate `json:"hourlyRate,omitempty"`
-	MembershipStatus string     `json:"membershipStatus,omitempty"`
-	MembershipType   string     `json:"membershipType,omitempty"`
-	TargetID         string     `json:"targetId,omitempty"`
-	UserID           string     `json:"userId,omitempty"`
+	HourlyRate       Money            `json:"hourlyRate,omitempty"`
+	CostRate         Money            `json:"costRate,omitempty"`
+	MembershipStatus MembershipStatus `json:"membershipStatus,omitempty"`
+	MembershipType   MembershipType   `json:"membershipType,omitempty"`
+	TargetID         string           `json:"targetId,omitempty"`
+	UserID           UserID           `json:"userId,omitempty"`
 }
 
 // AutomaticLock see: https://clockify.me/developers-api#tag-Workspace
 type AutomaticLock struct {
-	ChangeDay       string `json:"changeDay,omitempty"`
-	DayOfMonth      string `json:"dayOfMonth,omitempty"`
-	FirstDay        string `json:"firstDay,omitempty"`
-	OlderThanPeriod string `json:"olderThanPeriod,omitempty"`
-	OlderThanValue  string `json:"olderThanValue,omitempty"`
-	Type            string `json:"type,omitempty"`
+	ChangeDay       DayOfWeek         `json:"changeDay,omitempty"`
+	DayOfMonth      string            `json:"dayOfMonth,omitempty"`
+	FirstDay        DayOfWeek         `json:"firstDay,omitempty"`
+	OlderThanPeriod LockPeriod        `json:"olderThanPeriod,omitempty"`
+	OlderThanValue  string            `json:"olderThanValue,omitempty"`
+	Type            AutomaticLockType `json:"type,omitempty"`
 }
 
 // Round see: https://clockify.me/developers-api#tag-Workspace
 type Round struct {
-	Minutes string `json:"minutes,omitempty"`
-	Round   string `json:"round,omitempty"`
+	Minutes string    `json:"minutes,omitempty"`
+	Round   RoundType `json:"round,omitempty"`
 }
 
 // WorkspaceSettings see: https://clockify.me/developers-api#tag-Workspace
@@ -83,27 +82,145 @@ type WorkspaceSettings struct {
 	FeatureSubscriptionType            string        `json:"featureSubscriptionType,omitempty"`
 }
 
+type MembershipStatus string
+
+// Possible values of MembershipStatus
+const (
+	MembershipStatusPending  MembershipStatus = "PENDING"
+	MembershipStatusActive   MembershipStatus = "ACTIVE"
+	MembershipStatusDeclined MembershipStatus = "DECLINED"
+	MembershipStatusInactive MembershipStatus = "INACTIVE"
+	MembershipStatusUnknown  MembershipStatus = enumUnknown
+)
+
+// UnmarshalJSON decode MembershipStatus, unknown value decode into
+// MembershipStatusUnknown.
+func (m *MembershipStatus) UnmarshalJSON(data []byte) error {
+	v, err := unmarshalEnum(data, string(MembershipStatusUnknown),
+		string(MembershipStatusPending), string(MembershipStatusActive),
+		string(MembershipStatusDeclined), string(MembershipStatusInactive))
+	*m = MembershipStatus(v)
+	return err
+}
+
+type MembershipType string
+
+// Possible values of MembershipType
+const (
+	MembershipTypeWorkspace MembershipType = "WORKSPACE"
+	MembershipTypeProject   MembershipType = "PROJECT"
+	MembershipTypeUserGroup MembershipType = "USERGROUP"
+	MembershipTypeUnknown   MembershipType = enumUnknown
+)
+
+// UnmarshalJSON decode MembershipType, unknown value decode into
+// MembershipTypeUnknown.
+func (m *MembershipType) UnmarshalJSON(data []byte) error {
+	v, err := unmarshalEnum(data, string(MembershipTypeUnknown),
+		string(MembershipTypeWorkspace), string(MembershipTypeProject),
+		string(MembershipTypeUserGroup))
+	*m = MembershipType(v)
+	return err
+}
+
+type DayOfWeek string
+
+// Possible values of DayOfWeek
+const (
+	DayOfWeekMonday    DayOfWeek = "MONDAY"
+	DayOfWeekTuesday   DayOfWeek = "TUESDAY"
+	DayOfWeekWednesday DayOfWeek = "WEDNESDAY"
+	DayOfWeekThursday  DayOfWeek = "THURSDAY"
+	DayOfWeekFriday    DayOfWeek = "FRIDAY"
+	DayOfWeekSaturday  DayOfWeek = "SATURDAY"
+	DayOfWeekSunday    DayOfWeek = "SUNDAY"
+	DayOfWeekUnknown   DayOfWeek = enumUnknown
+)
+
+// UnmarshalJSON decode DayOfWeek, unknown value decode into DayOfWeekUnknown.
+func (d *DayOfWeek) UnmarshalJSON(data []byte) error {
+	v, err := unmarshalEnum(data, string(DayOfWeekUnknown), string(DayOfWeekMonday),
+		string(DayOfWeekTuesday), string(DayOfWeekWednesday), string(DayOfWeekThursday),
+		string(DayOfWeekFriday), string(DayOfWeekSaturday), string(DayOfWeekSunday))
+	*d = DayOfWeek(v)
+	return err
+}
+
+type AutomaticLockType string
+
+// Possible values of AutomaticLockType
+const (
+	AutomaticLockTypeWeekly    AutomaticLockType = "WEEKLY"
+	AutomaticLockTypeMonthly   AutomaticLockType = "MONTHLY"
+	AutomaticLockTypeOlderThan AutomaticLockType = "OLDER_THAN"
+	AutomaticLockTypeUnknown   AutomaticLockType = enumUnknown
+)
+
+// UnmarshalJSON decode AutomaticLockType, unknown value decode into
+// AutomaticLockTypeUnknown.
+func (a *AutomaticLockType) UnmarshalJSON(data []byte) error {
+	v, err := unmarshalEnum(data, string(AutomaticLockTypeUnknown),
+		string(AutomaticLockTypeWeekly), string(AutomaticLockTypeMonthly),
+		string(AutomaticLockTypeOlderThan))
+	*a = AutomaticLockType(v)
+	return err
+}
+
+type LockPeriod string
+
+// Possible values of LockPeriod
+const (
+	LockPeriodDays    LockPeriod = "DAYS"
+	LockPeriodWeeks   LockPeriod = "WEEKS"
+	LockPeriodMonths  LockPeriod = "MONTHS"
+	LockPeriodUnknown LockPeriod = enumUnknown
+)
+
+// UnmarshalJSON decode LockPeriod, unknown value decode into LockPeriodUnknown.
+func (l *LockPeriod) UnmarshalJSON(data []byte) error {
+	v, err := unmarshalEnum(data, string(LockPeriodUnknown), string(LockPeriodDays),
+		string(LockPeriodWeeks), string(LockPeriodMonths))
+	*l = LockPeriod(v)
+	return err
+}
+
+type RoundType string
+
+// Possible values of RoundType
+const (
+	RoundTypeNearest RoundType = "Round to nearest"
+	RoundTypeUp      RoundType = "Round up to"
+	RoundTypeDown    RoundType = "Round down to"
+	RoundTypeUnknown RoundType = enumUnknown
+)
+
+// UnmarshalJSON decode RoundType, unknown value decode into RoundTypeUnknown.
+func (r *RoundType) UnmarshalJSON(data []byte) error {
+	v, err := unmarshalEnum(data, string(RoundTypeUnknown), string(RoundTypeNearest),
+		string(RoundTypeUp), string(RoundTypeDown))
+	*r = RoundType(v)
+	return err
+}
+
 // All get all Workspace resource.
 func (w *WorkspaceNode) All(opts ...RequestOption) ([]Workspace, error) {
 	endpoint := fmt.Sprintf("%s/workspaces", w.endpoint)
-	res, err := get(workspaceAllRequest(w.apiKey, endpoint, opts))
+	res, err := w.requester.get(workspaceAllRequest(endpoint, opts))
 	if err != nil {
 		return nil, fmt.Errorf("get: %w", err)
 	}
 	result := make([]Workspace, 0)
-	if err := json.Unmarshal(res, &result); err != nil {
-		if jErr, ok := err.(*json.UnmarshalTypeError); ok {
-			return nil, fmt.Errorf("unmarshal field %v of type %v", jErr.Field, jErr.Type)
-		}
-		return nil, fmt.Errorf("json unmarshal: %w", err)
+	if err := w.requester.unmarshal(res, &result); err != nil {
+		return nil, err
 	}
 	return result, nil
 }
 
-func workspaceAllRequest(apiKey string, endpoint string, options []RequestOption) requestOptions {
+func workspaceAllRequest(endpoint string, options []RequestOption) requestOptions {
 	res := requestOptions{
-		apiKey:   apiKey,
-		endpoint: endpoint,
+		resource:  ResourceWorkspace,
+		operation: OperationList,
+		endpoint:  endpoint,
 	}
 	injectContext(&res, options)
 
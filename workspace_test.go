@@ -25,7 +25,7 @@ func (s *WorkspaceTestSuite) SetupTest() {
 	testMux.HandleFunc("/workspaces", s.all())
 
 	s.server = workspaceMockServer{
-		baseServer: httptest.NewServer(testMux),
+		baseServer: newJSONServer(testMux),
 	}
 }
 